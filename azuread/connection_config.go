@@ -5,14 +5,23 @@ import (
 )
 
 type azureADConfig struct {
-	TenantID            *string `hcl:"tenant_id"`
-	ClientID            *string `hcl:"client_id"`
-	ClientSecret        *string `hcl:"client_secret"`
-	CertificatePath     *string `hcl:"certificate_path"`
-	CertificatePassword *string `hcl:"certificate_password"`
-	EnableMsi           *bool   `hcl:"enable_msi"`
-	MsiEndpoint         *string `hcl:"msi_endpoint"`
-	Environment         *string `hcl:"environment"`
+	TenantID             *string `hcl:"tenant_id"`
+	ClientID             *string `hcl:"client_id"`
+	ClientSecret         *string `hcl:"client_secret"`
+	CertificatePath      *string `hcl:"certificate_path"`
+	CertificatePassword  *string `hcl:"certificate_password"`
+	EnableMsi            *bool   `hcl:"enable_msi"`
+	MsiEndpoint          *string `hcl:"msi_endpoint"`
+	Environment          *string `hcl:"environment"`
+	HttpProxy            *string `hcl:"http_proxy"`
+	HttpsProxy           *string `hcl:"https_proxy"`
+	CaCertPath           *string `hcl:"ca_cert_path"`
+	RequestTimeout       *int    `hcl:"request_timeout_seconds"`
+	MaxRetryWait         *int    `hcl:"max_retry_wait_seconds"`
+	ExpiryWarningDays    *int    `hcl:"credential_expiry_warning_days"`
+	DisplayNameCacheSize *int    `hcl:"display_name_cache_size"`
+	DisplayNameCacheTTL  *int    `hcl:"display_name_cache_ttl_seconds"`
+	IncludeRaw           *bool   `hcl:"include_raw"`
 }
 
 func ConfigInstance() interface{} {
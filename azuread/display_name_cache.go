@@ -0,0 +1,130 @@
+package azuread
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+const (
+	// defaultDisplayNameCacheSize is the number of directory objects whose names are kept when display_name_cache_size is not set
+	defaultDisplayNameCacheSize = 10000
+
+	// defaultDisplayNameCacheTTL is how long a name is kept when display_name_cache_ttl_seconds is not set
+	defaultDisplayNameCacheTTL = time.Hour
+)
+
+// directoryObjectName is the display name and the type of a directory object, e.g. a user or a service principal
+type directoryObjectName struct {
+	DisplayName *string
+	ObjectType  string
+}
+
+func newDirectoryObjectName(directoryObject *ADDirectoryObjectInfo) *directoryObjectName {
+	return &directoryObjectName{
+		DisplayName: directoryObject.DirectoryObjectDisplayName(),
+		ObjectType:  directoryObject.DirectoryObjectType(),
+	}
+}
+
+// displayNameCache is a bounded LRU cache of the names of the directory objects, keyed by object ID.
+// It is shared by the concurrent hydrates of a connection, so all its methods are guarded by a mutex.
+// A nil name is cached for a deleted object, so it is not looked up again until it expires.
+type displayNameCache struct {
+	mutex    sync.Mutex
+	capacity int
+	ttl      time.Duration
+	entries  map[string]*list.Element
+	order    *list.List
+}
+
+type displayNameCacheEntry struct {
+	objectId  string
+	name      *directoryObjectName
+	expiresAt time.Time
+}
+
+func newDisplayNameCache(capacity int, ttl time.Duration) *displayNameCache {
+	return &displayNameCache{
+		capacity: capacity,
+		ttl:      ttl,
+		entries:  map[string]*list.Element{},
+		order:    list.New(),
+	}
+}
+
+// Get returns the name of an object, nil for a deleted object, unless it is not cached or has expired
+func (cache *displayNameCache) Get(objectId string) (*directoryObjectName, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	element, ok := cache.entries[objectId]
+	if !ok {
+		return nil, false
+	}
+
+	entry := element.Value.(*displayNameCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		cache.order.Remove(element)
+		delete(cache.entries, objectId)
+		return nil, false
+	}
+
+	cache.order.MoveToFront(element)
+	return entry.name, true
+}
+
+// Add caches the name of an object, evicting the least recently used one when the cache is full
+func (cache *displayNameCache) Add(objectId string, name *directoryObjectName) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	expiresAt := time.Now().Add(cache.ttl)
+	if element, ok := cache.entries[objectId]; ok {
+		element.Value = &displayNameCacheEntry{objectId, name, expiresAt}
+		cache.order.MoveToFront(element)
+		return
+	}
+
+	cache.entries[objectId] = cache.order.PushFront(&displayNameCacheEntry{objectId, name, expiresAt})
+
+	for cache.order.Len() > cache.capacity {
+		oldest := cache.order.Back()
+		cache.order.Remove(oldest)
+		delete(cache.entries, oldest.Value.(*displayNameCacheEntry).objectId)
+	}
+}
+
+// displayNameCacheMutex ensures a single display name cache is created per connection
+var displayNameCacheMutex sync.Mutex
+
+// getDisplayNameCache returns the display name cache of the connection, sized from the display_name_cache_size and display_name_cache_ttl_seconds options.
+// It is kept in the connection cache, so it is shared across the tables and the queries, and reset when the connection config changes.
+func getDisplayNameCache(d *plugin.QueryData) *displayNameCache {
+	cacheKey := "getDisplayNameCache"
+
+	displayNameCacheMutex.Lock()
+	defer displayNameCacheMutex.Unlock()
+
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*displayNameCache)
+	}
+
+	capacity := defaultDisplayNameCacheSize
+	ttl := defaultDisplayNameCacheTTL
+
+	azureADConfig := GetConfig(d.Connection)
+	if azureADConfig.DisplayNameCacheSize != nil && *azureADConfig.DisplayNameCacheSize > 0 {
+		capacity = *azureADConfig.DisplayNameCacheSize
+	}
+	if azureADConfig.DisplayNameCacheTTL != nil && *azureADConfig.DisplayNameCacheTTL > 0 {
+		ttl = time.Duration(*azureADConfig.DisplayNameCacheTTL) * time.Second
+	}
+
+	cache := newDisplayNameCache(capacity, ttl)
+	d.ConnectionManager.Cache.Set(cacheKey, cache)
+
+	return cache
+}
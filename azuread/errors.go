@@ -50,3 +50,11 @@ func isIgnorableErrorPredicate(ignoreErrorCodes []string) plugin.ErrorPredicateW
 		return false
 	}
 }
+
+// The photos are stored in Exchange Online, so they can't be read for the users and the groups without a mailbox
+var photoIgnorableErrors = []string{"MailboxNotEnabledForRESTAPI", "ErrorNonExistentMailbox"}
+
+// isPhotoNotFoundError reports whether a profile photo request failed because the user or the group has no photo
+func isPhotoNotFoundError(err *RequestError) bool {
+	return err.Code == "ImageNotFound" || err.Code == "ErrorItemNotFound"
+}
@@ -0,0 +1,212 @@
+package azuread
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	abstractions "github.com/microsoft/kiota-abstractions-go"
+	"github.com/microsoft/kiota-abstractions-go/serialization"
+	"github.com/microsoftgraph/msgraph-sdk-go/models/odataerrors"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+const (
+	// pageFetchAttempts is the number of times a page is requested before the pagination fails
+	pageFetchAttempts = 3
+
+	// defaultMaxRetryWait is the total time waited between the attempts to fetch a page when max_retry_wait_seconds is not set
+	defaultMaxRetryWait = 60 * time.Second
+
+	// retryJitter is the fraction of the computed wait added or removed at random, so the concurrent hydrates throttled at the same time
+	// don't retry at the same time
+	retryJitter = 0.2
+)
+
+// iteratePages calls the callback with each item of a paged collection, starting with the first page already fetched, until the callback returns false.
+// Unlike msgraphcore.PageIterator, a page which fails to be fetched is requested again from the same @odata.nextLink, so a transient error
+// on page N neither fails the query nor restarts the scan from the first page. The headers, if any, are sent with every page request.
+func iteratePages[T any](ctx context.Context, d *plugin.QueryData, adapter abstractions.RequestAdapter, page serialization.Parsable, constructorFunc serialization.ParsableFactory, headers *abstractions.RequestHeaders, callback func(pageItem T) bool) error {
+	for pageIndex := 1; ; pageIndex++ {
+		items, nextLink, err := getPageContent[T](page)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			if !callback(item) {
+				return nil
+			}
+		}
+
+		if nextLink == nil || *nextLink == "" {
+			return nil
+		}
+
+		page, err = fetchPage(ctx, adapter, *nextLink, constructorFunc, headers, pageIndex+1, getMaxRetryWait(d))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// getPageContent returns the items and the @odata.nextLink of a page of a collection response
+func getPageContent[T any](page serialization.Parsable) ([]T, *string, error) {
+	if page == nil {
+		return nil, nil, nil
+	}
+
+	// The collection responses have no common interface, so the items are read the same way as msgraphcore.PageIterator does
+	getValue := reflect.ValueOf(page).MethodByName("GetValue")
+	if !getValue.IsValid() {
+		return nil, nil, fmt.Errorf("%T is not a collection response", page)
+	}
+
+	values := getValue.Call(nil)[0]
+	items := make([]T, 0, values.Len())
+	for i := 0; i < values.Len(); i++ {
+		item, ok := values.Index(i).Interface().(T)
+		if !ok {
+			return nil, nil, fmt.Errorf("item %d of %T is a %T, not a %s", i, page, values.Index(i).Interface(), reflect.TypeOf((*T)(nil)).Elem())
+		}
+		items = append(items, item)
+	}
+
+	var nextLink *string
+	if p, ok := page.(interface{ GetOdataNextLink() *string }); ok {
+		nextLink = p.GetOdataNextLink()
+	}
+
+	return items, nextLink, nil
+}
+
+// fetchPage requests the page at nextLink, retrying the transient errors as long as the total wait stays within maxRetryWait
+func fetchPage(ctx context.Context, adapter abstractions.RequestAdapter, nextLink string, constructorFunc serialization.ParsableFactory, headers *abstractions.RequestHeaders, pageIndex int, maxRetryWait time.Duration) (serialization.Parsable, error) {
+	uri, err := url.Parse(nextLink)
+	if err != nil {
+		return nil, err
+	}
+
+	errorMapping := abstractions.ErrorMappings{
+		"XXX": odataerrors.CreateODataErrorFromDiscriminatorValue,
+	}
+
+	var lastErr error
+	var totalWait time.Duration
+	for attempt := 1; attempt <= pageFetchAttempts; attempt++ {
+		requestInfo := abstractions.NewRequestInformation()
+		requestInfo.Method = abstractions.GET
+		requestInfo.SetUri(*uri)
+		requestInfo.Headers.Add("Accept", "application/json")
+		if headers != nil {
+			requestInfo.Headers.AddAll(headers)
+		}
+
+		page, err := adapter.Send(ctx, requestInfo, constructorFunc, errorMapping)
+		if err == nil {
+			return page, nil
+		}
+		lastErr = err
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if ctx.Err() != nil || !isTransientPageError(err) || attempt == pageFetchAttempts {
+			break
+		}
+
+		// Fail rather than keep the query waiting beyond the max_retry_wait_seconds of the connection
+		wait := pageRetryDelay(err, attempt, time.Now())
+		if totalWait+wait > maxRetryWait {
+			plugin.Logger(ctx).Warn("fetchPage", "page_index", pageIndex, "attempt", attempt, "next_link", nextLink, "retry_wait", wait, "max_retry_wait", maxRetryWait, "error", err)
+			break
+		}
+		totalWait += wait
+
+		plugin.Logger(ctx).Warn("fetchPage", "page_index", pageIndex, "attempt", attempt, "next_link", nextLink, "retry_wait", wait, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+
+	plugin.Logger(ctx).Error("fetchPage", "page_index", pageIndex, "next_link", nextLink, "paging_error", lastErr)
+	return nil, lastErr
+}
+
+// betaRequestURL returns the URL of a Microsoft Graph beta API path, in the national cloud of the adapter, for the resources
+// which are not exposed by the v1.0 API yet
+func betaRequestURL(adapter abstractions.RequestAdapter, path string, query url.Values) string {
+	requestURL := strings.TrimSuffix(adapter.GetBaseUrl(), "/v1.0") + "/beta" + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+	return requestURL
+}
+
+// getMaxRetryWait returns the total time to wait between the attempts to fetch a page, from the max_retry_wait_seconds of the connection
+func getMaxRetryWait(d *plugin.QueryData) time.Duration {
+	if d == nil {
+		return defaultMaxRetryWait
+	}
+
+	azureADConfig := GetConfig(d.Connection)
+	if azureADConfig.MaxRetryWait != nil && *azureADConfig.MaxRetryWait >= 0 {
+		return time.Duration(*azureADConfig.MaxRetryWait) * time.Second
+	}
+	return defaultMaxRetryWait
+}
+
+// pageRetryDelay returns how long to wait before requesting a page again: the Retry-After of the failed response if any,
+// an exponential backoff otherwise, with a random jitter of ±20%
+func pageRetryDelay(err error, attempt int, now time.Time) time.Duration {
+	delay := time.Duration(1<<(attempt-1)) * time.Second
+
+	if oDataError, ok := err.(*odataerrors.ODataError); ok && oDataError.ResponseHeaders != nil {
+		for _, value := range oDataError.ResponseHeaders.Get("Retry-After") {
+			if retryAfter, ok := retryAfterDelay(value, now); ok {
+				delay = retryAfter
+				break
+			}
+		}
+	}
+
+	return time.Duration(float64(delay) * (1 + retryJitter*(2*rand.Float64()-1)))
+}
+
+// retryAfterDelay parses the value of a Retry-After header, either a number of seconds or an HTTP date.
+// A date in the past means the request can be retried right away.
+func retryAfterDelay(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if delay := date.Sub(now); delay > 0 {
+		return delay, true
+	}
+	return 0, true
+}
+
+// isTransientPageError reports whether a failed page request is worth retrying. The throttling and unavailability responses are
+// already retried by the Graph client middleware, so this mostly covers the network errors and the other server errors.
+func isTransientPageError(err error) bool {
+	if oDataError, ok := err.(*odataerrors.ODataError); ok {
+		return oDataError.ResponseStatusCode == http.StatusTooManyRequests || oDataError.ResponseStatusCode >= http.StatusInternalServerError
+	}
+	return true
+}
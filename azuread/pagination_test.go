@@ -0,0 +1,228 @@
+package azuread
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	abstractions "github.com/microsoft/kiota-abstractions-go"
+	"github.com/microsoft/kiota-abstractions-go/serialization"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/models/odataerrors"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/context_key"
+)
+
+// stubRequestAdapter serves the pages of a collection, or the errors of the failing pages, by URL and records the URLs requested
+type stubRequestAdapter struct {
+	abstractions.RequestAdapter
+	pages     map[string]serialization.Parsable
+	errors    map[string]error
+	requested []string
+}
+
+func (adapter *stubRequestAdapter) Send(_ context.Context, requestInfo *abstractions.RequestInformation, _ serialization.ParsableFactory, _ abstractions.ErrorMappings) (serialization.Parsable, error) {
+	uri, err := requestInfo.GetUri()
+	if err != nil {
+		return nil, err
+	}
+
+	adapter.requested = append(adapter.requested, uri.String())
+	if err, ok := adapter.errors[uri.String()]; ok {
+		return nil, err
+	}
+	page, ok := adapter.pages[uri.String()]
+	if !ok {
+		return nil, fmt.Errorf("unexpected request to %s", uri.String())
+	}
+	return page, nil
+}
+
+func (adapter *stubRequestAdapter) GetBaseUrl() string {
+	return "https://graph.microsoft.com/v1.0"
+}
+
+// newRoleDefinitionPages returns the first page of a collection of role definitions and the adapter serving the next ones,
+// each page holding pageSize role definitions
+func newRoleDefinitionPages(pageCount int, pageSize int) (serialization.Parsable, *stubRequestAdapter) {
+	adapter := &stubRequestAdapter{pages: map[string]serialization.Parsable{}}
+
+	var first serialization.Parsable
+	for pageIndex := 1; pageIndex <= pageCount; pageIndex++ {
+		page := models.NewUnifiedRoleDefinitionCollectionResponse()
+
+		values := []models.UnifiedRoleDefinitionable{}
+		for i := 0; i < pageSize; i++ {
+			roleDefinition := models.NewUnifiedRoleDefinition()
+			id := fmt.Sprintf("role-%d-%d", pageIndex, i)
+			roleDefinition.SetId(&id)
+			values = append(values, roleDefinition)
+		}
+		page.SetValue(values)
+
+		if pageIndex < pageCount {
+			nextLink := fmt.Sprintf("https://graph.microsoft.com/v1.0/roleManagement/directory/roleDefinitions?$skiptoken=page%d", pageIndex+1)
+			page.SetOdataNextLink(&nextLink)
+		}
+
+		if pageIndex == 1 {
+			first = page
+		} else {
+			adapter.pages[fmt.Sprintf("https://graph.microsoft.com/v1.0/roleManagement/directory/roleDefinitions?$skiptoken=page%d", pageIndex)] = page
+		}
+	}
+
+	return first, adapter
+}
+
+func TestIteratePagesStopsFollowingNextLinksAtLimit(t *testing.T) {
+	ctx := context.WithValue(context.Background(), context_key.Logger, hclog.NewNullLogger())
+
+	tests := []struct {
+		name          string
+		limit         int
+		wantRequested []string
+		wantStreamed  int
+	}{
+		{
+			name:          "limit within the first page",
+			limit:         2,
+			wantRequested: nil,
+			wantStreamed:  2,
+		},
+		{
+			name:          "limit within the second page",
+			limit:         3,
+			wantRequested: []string{"https://graph.microsoft.com/v1.0/roleManagement/directory/roleDefinitions?$skiptoken=page2"},
+			wantStreamed:  3,
+		},
+		{
+			name:  "limit above the row count",
+			limit: 10,
+			wantRequested: []string{
+				"https://graph.microsoft.com/v1.0/roleManagement/directory/roleDefinitions?$skiptoken=page2",
+				"https://graph.microsoft.com/v1.0/roleManagement/directory/roleDefinitions?$skiptoken=page3",
+			},
+			wantStreamed: 6,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			first, adapter := newRoleDefinitionPages(3, 2)
+
+			// The callback stops the iteration the same way the tables do once d.RowsRemaining(ctx) reaches 0
+			remaining := test.limit
+			streamed := 0
+			err := iteratePages(ctx, nil, adapter, first, models.CreateUnifiedRoleDefinitionCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.UnifiedRoleDefinitionable) bool {
+				streamed++
+				remaining--
+				return remaining != 0
+			})
+			if err != nil {
+				t.Fatalf("iteratePages returned an error: %v", err)
+			}
+
+			if streamed != test.wantStreamed {
+				t.Errorf("streamed %d rows, want %d", streamed, test.wantStreamed)
+			}
+			if !reflect.DeepEqual(adapter.requested, test.wantRequested) {
+				t.Errorf("requested %v, want %v", adapter.requested, test.wantRequested)
+			}
+		})
+	}
+}
+
+// newThrottlingError returns the error of a throttled request, with the given Retry-After header if any
+func newThrottlingError(retryAfter string) *odataerrors.ODataError {
+	oDataError := odataerrors.NewODataError()
+	oDataError.SetStatusCode(http.StatusTooManyRequests)
+
+	responseHeaders := abstractions.NewResponseHeaders()
+	if retryAfter != "" {
+		responseHeaders.Add("Retry-After", retryAfter)
+	}
+	oDataError.SetResponseHeaders(responseHeaders)
+
+	return oDataError
+}
+
+func TestRetryAfterDelay(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		value     string
+		wantDelay time.Duration
+		wantOk    bool
+	}{
+		{name: "delta seconds", value: "120", wantDelay: 120 * time.Second, wantOk: true},
+		{name: "delta seconds with spaces", value: " 5 ", wantDelay: 5 * time.Second, wantOk: true},
+		{name: "zero delta seconds", value: "0", wantDelay: 0, wantOk: true},
+		{name: "negative delta seconds", value: "-1", wantOk: false},
+		{name: "HTTP date", value: "Fri, 01 Mar 2024 12:00:30 GMT", wantDelay: 30 * time.Second, wantOk: true},
+		{name: "HTTP date in RFC 850 format", value: "Friday, 01-Mar-24 12:01:00 GMT", wantDelay: time.Minute, wantOk: true},
+		{name: "HTTP date in the past", value: "Fri, 01 Mar 2024 11:59:00 GMT", wantDelay: 0, wantOk: true},
+		{name: "invalid value", value: "soon", wantOk: false},
+		{name: "empty value", value: "", wantOk: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			delay, ok := retryAfterDelay(test.value, now)
+			if ok != test.wantOk {
+				t.Fatalf("retryAfterDelay(%q) ok = %v, want %v", test.value, ok, test.wantOk)
+			}
+			if delay != test.wantDelay {
+				t.Errorf("retryAfterDelay(%q) = %s, want %s", test.value, delay, test.wantDelay)
+			}
+		})
+	}
+}
+
+func TestPageRetryDelayJitter(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		err     error
+		attempt int
+		want    time.Duration
+	}{
+		{name: "delta seconds Retry-After", err: newThrottlingError("10"), attempt: 1, want: 10 * time.Second},
+		{name: "HTTP date Retry-After", err: newThrottlingError("Fri, 01 Mar 2024 12:00:20 GMT"), attempt: 1, want: 20 * time.Second},
+		{name: "no Retry-After", err: newThrottlingError(""), attempt: 2, want: 2 * time.Second},
+		{name: "network error", err: fmt.Errorf("connection reset by peer"), attempt: 3, want: 4 * time.Second},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			low := time.Duration(float64(test.want) * (1 - retryJitter))
+			high := time.Duration(float64(test.want) * (1 + retryJitter))
+			for i := 0; i < 100; i++ {
+				if delay := pageRetryDelay(test.err, test.attempt, now); delay < low || delay > high {
+					t.Fatalf("pageRetryDelay = %s, want between %s and %s", delay, low, high)
+				}
+			}
+		})
+	}
+}
+
+func TestFetchPageStopsRetryingBeyondMaxRetryWait(t *testing.T) {
+	ctx := context.WithValue(context.Background(), context_key.Logger, hclog.NewNullLogger())
+
+	nextLink := "https://graph.microsoft.com/v1.0/roleManagement/directory/roleDefinitions?$skiptoken=page2"
+	throttlingError := newThrottlingError("120")
+	adapter := &stubRequestAdapter{errors: map[string]error{nextLink: throttlingError}}
+
+	_, err := fetchPage(ctx, adapter, nextLink, models.CreateUnifiedRoleDefinitionCollectionResponseFromDiscriminatorValue, nil, 2, time.Minute)
+	if err != throttlingError {
+		t.Fatalf("fetchPage returned %v, want the throttling error", err)
+	}
+	if len(adapter.requested) != 1 {
+		t.Errorf("requested the page %d times, want 1", len(adapter.requested))
+	}
+}
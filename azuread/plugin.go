@@ -29,27 +29,86 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			NewInstance: ConfigInstance,
 		},
 		TableMap: map[string]*plugin.Table{
-			"azuread_admin_consent_request_policy":           tableAzureAdAdminConsentRequestPolicy(ctx),
-			"azuread_application":                            tableAzureAdApplication(ctx),
-			"azuread_application_app_role_assigned_to":       tableAzureAdApplicationAppRoleAssignment(ctx),
-			"azuread_authorization_policy":                   tableAzureAdAuthorizationPolicy(ctx),
-			"azuread_conditional_access_named_location":      tableAzureAdConditionalAccessNamedLocation(ctx),
-			"azuread_conditional_access_policy":              tableAzureAdConditionalAccessPolicy(ctx),
-			"azuread_device":                                 tableAzureAdDevice(ctx),
-			"azuread_directory_audit_report":                 tableAzureAdDirectoryAuditReport(ctx),
-			"azuread_directory_role":                         tableAzureAdDirectoryRole(ctx),
-			"azuread_directory_setting":                      tableAzureAdDirectorySetting(ctx),
-			"azuread_domain":                                 tableAzureAdDomain(ctx),
-			"azuread_group":                                  tableAzureAdGroup(ctx),
-			"azuread_group_app_role_assignment":              tableAzureAdGroupAppRoleAssignment(ctx),
-			"azuread_identity_provider":                      tableAzureAdIdentityProvider(ctx),
-			"azuread_security_defaults_policy":               tableAzureAdSecurityDefaultsPolicy(ctx),
-			"azuread_service_principal":                      tableAzureAdServicePrincipal(ctx),
-			"azuread_service_principal_app_role_assigned_to": tableAzureAdServicePrincipalAppRoleAssignedTo(ctx),
-			"azuread_service_principal_app_role_assignment":  tableAzureAdServicePrincipalAppRoleAssignment(ctx),
-			"azuread_sign_in_report":                         tableAzureAdSignInReport(ctx),
-			"azuread_user":                                   tableAzureAdUser(ctx),
-			"azuread_user_app_role_assignment":               tableAzureAdUserAppRoleAssignment(ctx),
+			"azuread_admin_consent_request_policy":              tableAzureAdAdminConsentRequestPolicy(ctx),
+			"azuread_administrative_unit":                       tableAzureAdAdministrativeUnit(ctx),
+			"azuread_app_management_policy":                     tableAzureAdAppManagementPolicy(ctx),
+			"azuread_app_role":                                  tableAzureAdAppRole(ctx),
+			"azuread_application":                               tableAzureAdApplication(ctx),
+			"azuread_application_app_role_assigned_to":          tableAzureAdApplicationAppRoleAssignment(ctx),
+			"azuread_application_exposed_scope":                 tableAzureAdApplicationExposedScope(ctx),
+			"azuread_application_permission":                    tableAzureAdApplicationPermission(ctx),
+			"azuread_application_redirect_uri":                  tableAzureAdApplicationRedirectUri(ctx),
+			"azuread_authentication_strength_policy":            tableAzureAdAuthenticationStrengthPolicy(ctx),
+			"azuread_authorization_policy":                      tableAzureAdAuthorizationPolicy(ctx),
+			"azuread_b2c_identity_provider":                     tableAzureAdB2CIdentityProvider(ctx),
+			"azuread_b2c_user_attribute":                        tableAzureAdB2CUserAttribute(ctx),
+			"azuread_conditional_access_authentication_context": tableAzureAdConditionalAccessAuthenticationContext(ctx),
+			"azuread_conditional_access_coverage":               tableAzureAdConditionalAccessCoverage(ctx),
+			"azuread_conditional_access_excluded_principal":     tableAzureAdConditionalAccessExcludedPrincipal(ctx),
+			"azuread_conditional_access_named_location":         tableAzureAdConditionalAccessNamedLocation(ctx),
+			"azuread_conditional_access_policy":                 tableAzureAdConditionalAccessPolicy(ctx),
+			"azuread_cross_tenant_partner":                      tableAzureAdCrossTenantPartner(ctx),
+			"azuread_custom_security_attribute_definition":      tableAzureAdCustomSecurityAttributeDefinition(ctx),
+			"azuread_delegated_permission_classification":       tableAzureAdDelegatedPermissionClassification(ctx),
+			"azuread_device":                                    tableAzureAdDevice(ctx),
+			"azuread_directory_audit_report":                    tableAzureAdDirectoryAuditReport(ctx),
+			"azuread_directory_object":                          tableAzureAdDirectoryObject(ctx),
+			"azuread_directory_object_member_of":                tableAzureAdDirectoryObjectMemberOf(ctx),
+			"azuread_directory_objects_by_ids":                  tableAzureAdDirectoryObjectsByIds(ctx),
+			"azuread_directory_role":                            tableAzureAdDirectoryRole(ctx),
+			"azuread_directory_role_assignment":                 tableAzureAdDirectoryRoleAssignment(ctx),
+			"azuread_directory_role_member":                     tableAzureAdDirectoryRoleMember(ctx),
+			"azuread_directory_role_template":                   tableAzureAdDirectoryRoleTemplate(ctx),
+			"azuread_directory_setting":                         tableAzureAdDirectorySetting(ctx),
+			"azuread_domain":                                    tableAzureAdDomain(ctx),
+			"azuread_domain_verification_dns_record":            tableAzureAdDomainVerificationDnsRecord(ctx),
+			"azuread_entitlement_management_access_package":     tableAzureAdEntitlementManagementAccessPackage(ctx),
+			"azuread_external_identities_policy":                tableAzureAdExternalIdentitiesPolicy(ctx),
+			"azuread_group":                                     tableAzureAdGroup(ctx),
+			"azuread_group_app_role_assignment":                 tableAzureAdGroupAppRoleAssignment(ctx),
+			"azuread_group_assigned_license":                    tableAzureAdGroupAssignedLicense(ctx),
+			"azuread_group_membership_change":                   tableAzureAdGroupMembershipChange(ctx),
+			"azuread_group_pim_assignment":                      tableAzureAdGroupPimAssignment(ctx),
+			"azuread_guest_invitation":                          tableAzureAdGuestInvitation(ctx),
+			"azuread_home_realm_discovery_policy":               tableAzureAdHomeRealmDiscoveryPolicy(ctx),
+			"azuread_identity_governance_lifecycle_workflow":    tableAzureAdIdentityGovernanceLifecycleWorkflow(ctx),
+			"azuread_identity_provider":                         tableAzureAdIdentityProvider(ctx),
+			"azuread_organization_branding":                     tableAzureAdOrganizationBranding(ctx),
+			"azuread_pim_assignment_schedule":                   tableAzureAdPimAssignmentSchedule(ctx),
+			"azuread_pim_assignment_schedule_instance":          tableAzureAdPimAssignmentScheduleInstance(ctx),
+			"azuread_pim_eligibility_schedule":                  tableAzureAdPimEligibilitySchedule(ctx),
+			"azuread_policy":                                    tableAzureAdPolicy(ctx),
+			"azuread_principal_app_role_assignment":             tableAzureAdPrincipalAppRoleAssignment(ctx),
+			"azuread_provisioning_log":                          tableAzureAdProvisioningLog(ctx),
+			"azuread_risk_detection":                            tableAzureAdRiskDetection(ctx),
+			"azuread_role_definition_resource_action":           tableAzureAdRoleDefinitionResourceAction(ctx),
+			"azuread_role_management_policy":                    tableAzureAdRoleManagementPolicy(ctx),
+			"azuread_role_schedule_request":                     tableAzureAdRoleScheduleRequest(ctx),
+			"azuread_secure_score":                              tableAzureAdSecureScore(ctx),
+			"azuread_secure_score_control_profile":              tableAzureAdSecureScoreControlProfile(ctx),
+			"azuread_security_defaults_policy":                  tableAzureAdSecurityDefaultsPolicy(ctx),
+			"azuread_service_health":                            tableAzureAdServiceHealth(ctx),
+			"azuread_service_message":                           tableAzureAdServiceMessage(ctx),
+			"azuread_service_principal":                         tableAzureAdServicePrincipal(ctx),
+			"azuread_service_principal_app_role_assigned_to":    tableAzureAdServicePrincipalAppRoleAssignedTo(ctx),
+			"azuread_service_principal_app_role_assignment":     tableAzureAdServicePrincipalAppRoleAssignment(ctx),
+			"azuread_service_principal_credential":              tableAzureAdServicePrincipalCredential(ctx),
+			"azuread_service_principal_policy_assignment":       tableAzureAdServicePrincipalPolicyAssignment(ctx),
+			"azuread_service_principal_sign_in":                 tableAzureAdServicePrincipalSignIn(ctx),
+			"azuread_service_principal_sign_in_activity":        tableAzureAdServicePrincipalSignInActivity(ctx),
+			"azuread_service_principal_token_policy":            tableAzureAdServicePrincipalTokenPolicy(ctx),
+			"azuread_sign_in_report":                            tableAzureAdSignInReport(ctx),
+			"azuread_tenant":                                    tableAzureAdTenant(ctx),
+			"azuread_tenant_relationship":                       tableAzureAdTenantRelationship(ctx),
+			"azuread_terms_of_use_agreement":                    tableAzureAdTermsOfUseAgreement(ctx),
+			"azuread_trusted_certificate_authority":             tableAzureAdTrustedCertificateAuthority(ctx),
+			"azuread_user":                                      tableAzureAdUser(ctx),
+			"azuread_user_app_role_assignment":                  tableAzureAdUserAppRoleAssignment(ctx),
+			"azuread_user_owned_device":                         tableAzureAdUserOwnedDevice(ctx),
+			"azuread_user_owned_object":                         tableAzureAdUserOwnedObject(ctx),
+			"azuread_user_registered_device":                    tableAzureAdUserRegisteredDevice(ctx),
+			"azuread_user_role_eligibility":                     tableAzureAdUserRoleEligibility(ctx),
+			"azuread_user_transitive_role_assignment":           tableAzureAdUserTransitiveRoleAssignment(ctx),
 		},
 	}
 
@@ -4,19 +4,28 @@ import (
 	"bytes"
 	"context"
 	"crypto"
+	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
 	"os"
 	"os/exec"
 	"runtime"
+	"sync"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	a "github.com/microsoft/kiota-authentication-azure-go"
+	khttp "github.com/microsoft/kiota-http-go"
 	msgraphsdkgo "github.com/microsoftgraph/msgraph-sdk-go"
+	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 )
 
@@ -96,8 +105,21 @@ func GetGraphClient(ctx context.Context, d *plugin.QueryData) (*msgraphsdkgo.Gra
 		cloudConfiguration = cloud.AzurePublic
 	}
 
+	// Use the proxy and CA certificate options, if any, for both the authentication and the Graph requests
+	transport, err := getHTTPTransport(d, azureADConfig)
+	if err != nil {
+		logger.Error("GetGraphClient", "http_transport_error", err)
+		return nil, nil, err
+	}
+
+	clientOptions := policy.ClientOptions{
+		Cloud: cloudConfiguration,
+	}
+	if transport != nil {
+		clientOptions.Transport = &http.Client{Transport: transport}
+	}
+
 	var cred azcore.TokenCredential
-	var err error
 	if tenantID == "" { // CLI authentication
 		cred, err = azidentity.NewAzureCLICredential(
 			&azidentity.AzureCLICredentialOptions{},
@@ -112,9 +134,7 @@ func GetGraphClient(ctx context.Context, d *plugin.QueryData) (*msgraphsdkgo.Gra
 			clientID,
 			clientSecret,
 			&azidentity.ClientSecretCredentialOptions{
-				ClientOptions: policy.ClientOptions{
-					Cloud: cloudConfiguration,
-				},
+				ClientOptions: clientOptions,
 			},
 		)
 		if err != nil {
@@ -146,9 +166,7 @@ func GetGraphClient(ctx context.Context, d *plugin.QueryData) (*msgraphsdkgo.Gra
 			certs,
 			key,
 			&azidentity.ClientCertificateCredentialOptions{
-				ClientOptions: policy.ClientOptions{
-					Cloud: cloudConfiguration,
-				},
+				ClientOptions: clientOptions,
 			},
 		)
 		if err != nil {
@@ -157,7 +175,9 @@ func GetGraphClient(ctx context.Context, d *plugin.QueryData) (*msgraphsdkgo.Gra
 		}
 	} else if enableMsi { // Managed identity authentication
 		cred, err = azidentity.NewManagedIdentityCredential(
-			&azidentity.ManagedIdentityCredentialOptions{},
+			&azidentity.ManagedIdentityCredentialOptions{
+				ClientOptions: clientOptions,
+			},
 		)
 		if err != nil {
 			logger.Error("GetGraphClient", "managed_identity_credential_error", err)
@@ -178,7 +198,33 @@ func GetGraphClient(ctx context.Context, d *plugin.QueryData) (*msgraphsdkgo.Gra
 		return nil, nil, fmt.Errorf("error creating authentication provider: %v", err)
 	}
 
-	adapter, err := msgraphsdkgo.NewGraphRequestAdapter(auth)
+	var requestTimeout time.Duration
+	if azureADConfig.RequestTimeout != nil && *azureADConfig.RequestTimeout > 0 {
+		requestTimeout = time.Duration(*azureADConfig.RequestTimeout) * time.Second
+	}
+
+	var adapter *msgraphsdkgo.GraphRequestAdapter
+	if transport != nil || requestTimeout > 0 {
+		// Keep the default Graph middlewares (retry, redirect, compression...) on top of the custom transport
+		graphClientOptions := msgraphsdkgo.GetDefaultClientOptions()
+		middlewares := msgraphcore.GetDefaultMiddlewaresWithOptions(&graphClientOptions)
+
+		// The timeout is the outermost middleware, so it bounds a whole Graph call, retries included
+		if requestTimeout > 0 {
+			middlewares = append([]khttp.Middleware{&requestTimeoutHandler{tableName: d.Table.Name, timeout: requestTimeout}}, middlewares...)
+		}
+
+		httpClient := msgraphcore.GetDefaultClient(&graphClientOptions)
+		if transport != nil {
+			httpClient.Transport = khttp.NewCustomTransportWithParentTransport(transport, middlewares...)
+		} else {
+			httpClient.Transport = khttp.NewCustomTransport(middlewares...)
+		}
+
+		adapter, err = msgraphsdkgo.NewGraphRequestAdapterWithParseNodeFactoryAndSerializationWriterFactoryAndHttpClient(auth, nil, nil, httpClient)
+	} else {
+		adapter, err = msgraphsdkgo.NewGraphRequestAdapter(auth)
+	}
 	if err != nil {
 		return nil, nil, fmt.Errorf("error creating graph adapter: %v", err)
 	}
@@ -197,9 +243,136 @@ func GetGraphClient(ctx context.Context, d *plugin.QueryData) (*msgraphsdkgo.Gra
 	return client, adapter, nil
 }
 
+// httpTransportMutex serializes the uncached transport creations, so the concurrent clients of a connection share a single transport
+var httpTransportMutex sync.Mutex
+
+// getHTTPTransport returns the transport to use when a proxy or a custom CA certificate is configured for the connection.
+// It returns nil otherwise, so the SDK defaults, which already honor the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables, are kept.
+// The transport is cached per connection and options, so the clients created by each hydrate reuse its connection pool.
+func getHTTPTransport(d *plugin.QueryData, config azureADConfig) (*http.Transport, error) {
+	var httpProxy, httpsProxy, caCertPath string
+	if config.HttpProxy != nil {
+		httpProxy = *config.HttpProxy
+	}
+	if config.HttpsProxy != nil {
+		httpsProxy = *config.HttpsProxy
+	}
+	if config.CaCertPath != nil {
+		caCertPath = *config.CaCertPath
+	}
+
+	if httpProxy == "" && httpsProxy == "" && caCertPath == "" {
+		return nil, nil
+	}
+
+	cacheKey := fmt.Sprintf("getHTTPTransport-%s|%s|%s", httpProxy, httpsProxy, caCertPath)
+
+	httpTransportMutex.Lock()
+	defer httpTransportMutex.Unlock()
+
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*http.Transport), nil
+	}
+
+	transport, err := buildHTTPTransport(httpProxy, httpsProxy, caCertPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// save to extension cache
+	d.ConnectionManager.Cache.Set(cacheKey, transport)
+
+	return transport, nil
+}
+
+// buildHTTPTransport returns a transport using the given proxies and trusting the given CA certificate in addition to the system roots
+func buildHTTPTransport(httpProxy, httpsProxy, caCertPath string) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if httpProxy != "" || httpsProxy != "" {
+		proxies := map[string]*url.URL{}
+		for scheme, proxy := range map[string]string{"http": httpProxy, "https": httpsProxy} {
+			if proxy == "" {
+				continue
+			}
+			proxyURL, err := url.Parse(proxy)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing %s_proxy %s: %v", scheme, proxy, err)
+			}
+			proxies[scheme] = proxyURL
+		}
+
+		// Requests for a scheme without a configured proxy fall back to the environment variables
+		transport.Proxy = func(req *http.Request) (*url.URL, error) {
+			if proxyURL, ok := proxies[req.URL.Scheme]; ok {
+				return proxyURL, nil
+			}
+			return http.ProxyFromEnvironment(req)
+		}
+	}
+
+	if caCertPath != "" {
+		caCert, err := os.ReadFile(caCertPath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading CA certificate from %s: %v", caCertPath, err)
+		}
+
+		// Add the certificate to the system roots, so the public endpoints are still trusted
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("error parsing CA certificate from %s: no PEM encoded certificate found", caCertPath)
+		}
+
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    rootCAs,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	return transport, nil
+}
+
+// requestTimeoutHandler is a Graph middleware which cancels a request taking longer than the request_timeout_seconds of the connection
+type requestTimeoutHandler struct {
+	tableName string
+	timeout   time.Duration
+}
+
+func (handler *requestTimeoutHandler) Intercept(pipeline khttp.Pipeline, middlewareIndex int, req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), handler.timeout)
+
+	resp, err := pipeline.Next(req.WithContext(ctx), middlewareIndex)
+	if err != nil {
+		cancel()
+
+		// Only report the timeout of the connection, not a cancellation of the query
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) && req.Context().Err() == nil {
+			return nil, fmt.Errorf("%s: %s %s timed out after %s, the request_timeout_seconds of the connection", handler.tableName, req.Method, req.URL.Path, handler.timeout)
+		}
+		return nil, err
+	}
+
+	// The body is read after the middlewares return, so the context is only released when the body is closed
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (body *cancelOnCloseBody) Close() error {
+	defer body.cancel()
+	return body.ReadCloser.Close()
+}
+
 // https://github.com/Azure/go-autorest/blob/3fb5326fea196cd5af02cf105ca246a0fba59021/autorest/azure/cli/token.go#L126
 // NewAuthorizerFromCLIWithResource creates an Authorizer configured from Azure CLI 2.0 for local development scenarios.
-func getTenantFromCLI() (string, error) {
+func getTenantFromCLI(ctx context.Context) (string, error) {
 	// This is the path that a developer can set to tell this class what the install path for Azure CLI is.
 	const azureCLIPath = "AzureCLIPath"
 
@@ -212,12 +385,12 @@ func getTenantFromCLI() (string, error) {
 	// Execute Azure CLI to get token
 	var cliCmd *exec.Cmd
 	if runtime.GOOS == "windows" {
-		cliCmd = exec.Command(fmt.Sprintf("%s\\system32\\cmd.exe", os.Getenv("windir")))
+		cliCmd = exec.CommandContext(ctx, fmt.Sprintf("%s\\system32\\cmd.exe", os.Getenv("windir")))
 		cliCmd.Env = os.Environ()
 		cliCmd.Env = append(cliCmd.Env, fmt.Sprintf("PATH=%s;%s", os.Getenv(azureCLIPath), azureCLIDefaultPathWindows))
 		cliCmd.Args = append(cliCmd.Args, "/c", "az")
 	} else {
-		cliCmd = exec.Command("az")
+		cliCmd = exec.CommandContext(ctx, "az")
 		cliCmd.Env = os.Environ()
 		cliCmd.Env = append(cliCmd.Env, fmt.Sprintf("PATH=%s:%s", os.Getenv(azureCLIPath), azureCLIDefaultPath))
 	}
@@ -0,0 +1,208 @@
+package azuread
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/directory"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// The isMemberManagementRestricted property is missing from the SDK models, so it is requested explicitly and read from the additional data
+var administrativeUnitSelectFields = []string{"id", "displayName", "description", "visibility", "isMemberManagementRestricted"}
+
+//// TABLE DEFINITION
+
+func tableAzureAdAdministrativeUnit(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_administrative_unit",
+		Description: "Represents an Azure AD administrative unit, which restricts the scope of the role assignments to a subset of the users, groups and devices of the tenant.",
+		Get: &plugin.GetConfig{
+			Hydrate: getAdAdministrativeUnit,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAdAdministrativeUnits,
+			KeyColumns: plugin.KeyColumnSlice{
+				// Key fields
+				{Name: "display_name", Require: plugin.Optional},
+				{Name: "is_member_management_restricted", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name of the administrative unit.", Transform: transform.FromMethod("GetDisplayName")},
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the administrative unit.", Transform: transform.FromMethod("GetId")},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "An optional description of the administrative unit.", Transform: transform.FromMethod("GetDescription")},
+
+			// Other fields
+			{Name: "is_member_management_restricted", Type: proto.ColumnType_BOOL, Description: "True if the administrative unit is a restricted management administrative unit, whose members can only be managed by the administrators assigned to the unit.", Transform: transform.FromMethod("AdministrativeUnitIsMemberManagementRestricted")},
+			{Name: "restriction_type", Type: proto.ColumnType_STRING, Description: "The restriction of the management of the members. Possible values are: restrictedManagement, none.", Transform: transform.FromMethod("AdministrativeUnitRestrictionType")},
+			{Name: "visibility", Type: proto.ColumnType_STRING, Description: "The visibility of the administrative unit. Possible values are: HiddenMembership, Public. Defaults to Public when not set.", Transform: transform.FromMethod("GetVisibility")},
+
+			// JSON fields
+			{Name: "member_ids", Type: proto.ColumnType_JSON, Description: "The IDs of the users, groups and devices which are members of the administrative unit. The members of a restricted management administrative unit can be read like the members of any other unit.", Hydrate: getAdAdministrativeUnitMembers, Transform: transform.FromValue()},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adAdministrativeUnitTitle)},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdAdministrativeUnits(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_administrative_unit.listAdAdministrativeUnits", "connection_error", err)
+		return nil, err
+	}
+
+	// List operations
+	input := &directory.AdministrativeUnitsRequestBuilderGetQueryParameters{
+		Top:    Int32(999),
+		Select: administrativeUnitSelectFields,
+	}
+
+	// Restrict the limit value to be passed in the query parameter which is not between 1 and 999, otherwise API will throw an error as follow
+	// unexpected status 400 with OData error: Request_UnsupportedQuery: Invalid page size specified: '1000'. Must be between 1 and 999 inclusive.
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 999 {
+			l := int32(*limit)
+			input.Top = Int32(l)
+		}
+	}
+
+	var filter []string
+	if d.EqualsQuals["display_name"] != nil {
+		filter = append(filter, fmt.Sprintf("displayName eq '%s'", escapeODataString(d.EqualsQuals["display_name"].GetStringValue())))
+	}
+	if d.EqualsQuals["is_member_management_restricted"] != nil {
+		filter = append(filter, fmt.Sprintf("isMemberManagementRestricted eq %t", d.EqualsQuals["is_member_management_restricted"].GetBoolValue()))
+	}
+
+	if len(filter) > 0 {
+		joinStr := strings.Join(filter, " and ")
+		input.Filter = &joinStr
+	}
+
+	options := &directory.AdministrativeUnitsRequestBuilderGetRequestConfiguration{
+		QueryParameters: input,
+	}
+
+	result, err := client.Directory().AdministrativeUnits().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdAdministrativeUnits", "list_administrative_unit_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateAdministrativeUnitCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.AdministrativeUnitable) bool {
+		d.StreamListItem(ctx, &ADAdministrativeUnitInfo{pageItem})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdAdministrativeUnits", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAdAdministrativeUnit(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	administrativeUnitId := d.EqualsQuals["id"].GetStringValue()
+	if administrativeUnitId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_administrative_unit.getAdAdministrativeUnit", "connection_error", err)
+		return nil, err
+	}
+
+	options := &directory.AdministrativeUnitsAdministrativeUnitItemRequestBuilderGetRequestConfiguration{
+		QueryParameters: &directory.AdministrativeUnitsAdministrativeUnitItemRequestBuilderGetQueryParameters{
+			Select: administrativeUnitSelectFields,
+		},
+	}
+
+	administrativeUnit, err := client.Directory().AdministrativeUnits().ByAdministrativeUnitId(administrativeUnitId).Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getAdAdministrativeUnit", "get_administrative_unit_error", errObj)
+		return nil, errObj
+	}
+
+	return &ADAdministrativeUnitInfo{administrativeUnit}, nil
+}
+
+func getAdAdministrativeUnitMembers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_administrative_unit.getAdAdministrativeUnitMembers", "connection_error", err)
+		return nil, err
+	}
+
+	administrativeUnit := h.Item.(*ADAdministrativeUnitInfo)
+	administrativeUnitId := administrativeUnit.GetId()
+
+	// Only the IDs of the members are read
+	options := &directory.AdministrativeUnitsItemMembersRequestBuilderGetRequestConfiguration{
+		QueryParameters: &directory.AdministrativeUnitsItemMembersRequestBuilderGetQueryParameters{
+			Select: []string{"id"},
+		},
+	}
+
+	memberIds := []*string{}
+	members, err := client.Directory().AdministrativeUnits().ByAdministrativeUnitId(*administrativeUnitId).Members().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getAdAdministrativeUnitMembers", "get_administrative_unit_members_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, members, models.CreateDirectoryObjectCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.DirectoryObjectable) bool {
+		memberIds = append(memberIds, pageItem.GetId())
+
+		return true
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("getAdAdministrativeUnitMembers", "paging_error", err)
+		return nil, err
+	}
+
+	return memberIds, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func adAdministrativeUnitTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADAdministrativeUnitInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	title := data.GetDisplayName()
+	if title == nil {
+		title = data.GetId()
+	}
+
+	return title, nil
+}
@@ -0,0 +1,224 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdAppManagementPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_app_management_policy",
+		Description: "Represents the tenant default or a custom app management policy, with the credential restrictions effectively enforced on the applications it applies to.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdAppManagementPolicies,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "policy_type", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the policy.", Transform: transform.FromField("Id")},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name of the policy.", Transform: transform.FromField("DisplayName")},
+			{Name: "policy_type", Type: proto.ColumnType_STRING, Description: "The type of the policy. Possible values are: default, for the tenant default policy, and custom, for a policy applied to specific applications and service principals.", Transform: transform.FromField("PolicyType")},
+			{Name: "is_enabled", Type: proto.ColumnType_BOOL, Description: "True if the policy is enabled.", Transform: transform.FromField("IsEnabled")},
+
+			// Other fields
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the policy.", Transform: transform.FromField("Description")},
+			{Name: "max_password_credential_lifetime_days", Type: proto.ColumnType_INT, Description: "The maximum lifetime, in days, of the passwords effectively allowed by the policy. Null if the lifetime of the passwords is not restricted.", Transform: transform.FromMethod("AppManagementPolicyMaxPasswordCredentialLifetimeDays")},
+			{Name: "password_credentials_blocked", Type: proto.ColumnType_BOOL, Description: "True if the addition of passwords is effectively blocked by the policy.", Transform: transform.FromMethod("AppManagementPolicyPasswordCredentialsBlocked")},
+			{Name: "max_certificate_lifetime_days", Type: proto.ColumnType_INT, Description: "The maximum lifetime, in days, of the certificates effectively allowed by the policy. Null if the lifetime of the certificates is not restricted.", Transform: transform.FromMethod("AppManagementPolicyMaxCertificateLifetimeDays")},
+
+			// JSON fields
+			{Name: "password_credentials", Type: proto.ColumnType_JSON, Description: "The restrictions on the password credentials effectively enforced by the policy, merged with the restrictions of the tenant default policy for a custom policy.", Transform: transform.FromMethod("AppManagementPolicyPasswordCredentials")},
+			{Name: "key_credentials", Type: proto.ColumnType_JSON, Description: "The restrictions on the key credentials effectively enforced by the policy, merged with the restrictions of the tenant default policy for a custom policy.", Transform: transform.FromMethod("AppManagementPolicyKeyCredentials")},
+			{Name: "applies_to", Type: proto.ColumnType_JSON, Description: "The IDs of the applications and service principals a custom policy is applied to.", Hydrate: getAdAppManagementPolicyAppliesTo, Transform: transform.FromValue()},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adAppManagementPolicyTitle)},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdAppManagementPolicies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_app_management_policy.listAdAppManagementPolicies", "connection_error", err)
+		return nil, err
+	}
+
+	policyType := d.EqualsQuals["policy_type"].GetStringValue()
+
+	// The default policy is always read, since its restrictions apply to the applications which have no custom policy, and fill the gaps of the custom policies
+	defaultPolicy, err := client.Policies().DefaultAppManagementPolicy().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdAppManagementPolicies", "get_default_app_management_policy_error", errObj)
+		return nil, errObj
+	}
+
+	var defaultRestrictions models.AppManagementConfigurationable
+	if defaultPolicy.GetIsEnabled() != nil && *defaultPolicy.GetIsEnabled() {
+		defaultRestrictions = defaultPolicy.GetApplicationRestrictions()
+	}
+
+	if policyType == "" || policyType == "default" {
+		passwordCredentials, keyCredentials := mergeAppManagementRestrictions(defaultPolicy.GetApplicationRestrictions(), nil)
+		d.StreamListItem(ctx, &ADAppManagementPolicyInfo{
+			Id:                  defaultPolicy.GetId(),
+			DisplayName:         defaultPolicy.GetDisplayName(),
+			Description:         defaultPolicy.GetDescription(),
+			PolicyType:          "default",
+			IsEnabled:           defaultPolicy.GetIsEnabled(),
+			PasswordCredentials: passwordCredentials,
+			KeyCredentials:      keyCredentials,
+		})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	if policyType != "" && policyType != "custom" {
+		return nil, nil
+	}
+
+	result, err := client.Policies().AppManagementPolicies().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdAppManagementPolicies", "list_app_management_policy_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateAppManagementPolicyCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.AppManagementPolicyable) bool {
+		// A disabled custom policy enforces nothing, so the applications it is applied to fall back to the default policy
+		var customRestrictions models.AppManagementConfigurationable
+		if pageItem.GetIsEnabled() != nil && *pageItem.GetIsEnabled() {
+			customRestrictions = pageItem.GetRestrictions()
+		}
+
+		passwordCredentials, keyCredentials := mergeAppManagementRestrictions(customRestrictions, defaultRestrictions)
+		d.StreamListItem(ctx, &ADAppManagementPolicyInfo{
+			Id:                  pageItem.GetId(),
+			DisplayName:         pageItem.GetDisplayName(),
+			Description:         pageItem.GetDescription(),
+			PolicyType:          "custom",
+			IsEnabled:           pageItem.GetIsEnabled(),
+			PasswordCredentials: passwordCredentials,
+			KeyCredentials:      keyCredentials,
+		})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdAppManagementPolicies", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// mergeAppManagementRestrictions returns the restrictions of a policy, completed with the restrictions of the fallback policy whose type
+// the policy doesn't restrict. A custom policy overrides the default policy one restriction type at a time, e.g. a custom policy which only
+// restricts the password lifetime still blocks the addition of passwords if the default policy does.
+func mergeAppManagementRestrictions(restrictions models.AppManagementConfigurationable, fallback models.AppManagementConfigurationable) ([]models.PasswordCredentialConfigurationable, []models.KeyCredentialConfigurationable) {
+	passwordCredentials := []models.PasswordCredentialConfigurationable{}
+	keyCredentials := []models.KeyCredentialConfigurationable{}
+
+	passwordRestrictionTypes := map[string]bool{}
+	keyRestrictionTypes := map[string]bool{}
+	if restrictions != nil {
+		for _, c := range restrictions.GetPasswordCredentials() {
+			passwordCredentials = append(passwordCredentials, c)
+			if c.GetRestrictionType() != nil {
+				passwordRestrictionTypes[c.GetRestrictionType().String()] = true
+			}
+		}
+		for _, c := range restrictions.GetKeyCredentials() {
+			keyCredentials = append(keyCredentials, c)
+			if c.GetRestrictionType() != nil {
+				keyRestrictionTypes[c.GetRestrictionType().String()] = true
+			}
+		}
+	}
+
+	if fallback != nil {
+		for _, c := range fallback.GetPasswordCredentials() {
+			if c.GetRestrictionType() == nil || !passwordRestrictionTypes[c.GetRestrictionType().String()] {
+				passwordCredentials = append(passwordCredentials, c)
+			}
+		}
+		for _, c := range fallback.GetKeyCredentials() {
+			if c.GetRestrictionType() == nil || !keyRestrictionTypes[c.GetRestrictionType().String()] {
+				keyCredentials = append(keyCredentials, c)
+			}
+		}
+	}
+
+	return passwordCredentials, keyCredentials
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAdAppManagementPolicyAppliesTo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	policy := h.Item.(*ADAppManagementPolicyInfo)
+
+	// The default policy applies to the whole tenant
+	if policy.PolicyType != "custom" || policy.Id == nil {
+		return nil, nil
+	}
+
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_app_management_policy.getAdAppManagementPolicyAppliesTo", "connection_error", err)
+		return nil, err
+	}
+
+	objectIds := []*string{}
+	objects, err := client.Policies().AppManagementPolicies().ByAppManagementPolicyId(*policy.Id).AppliesTo().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getAdAppManagementPolicyAppliesTo", "get_applies_to_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, objects, models.CreateDirectoryObjectCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.DirectoryObjectable) bool {
+		objectIds = append(objectIds, pageItem.GetId())
+
+		return true
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("getAdAppManagementPolicyAppliesTo", "paging_error", err)
+		return nil, err
+	}
+
+	return objectIds, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func adAppManagementPolicyTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADAppManagementPolicyInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	title := data.DisplayName
+	if title == nil {
+		title = data.Id
+	}
+
+	return title, nil
+}
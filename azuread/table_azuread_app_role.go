@@ -0,0 +1,103 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/serviceprincipals"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdAppRole(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_app_role",
+		Description: "Represents an app role published by a service principal, which can be assigned to users, groups or other service principals.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdAppRoles,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "resource_id", Require: plugin.Required},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "resource_id", Type: proto.ColumnType_STRING, Description: "The unique identifier (id) of the service principal which publishes the app role.", Transform: transform.FromField("ResourceId")},
+			{Name: "app_role_id", Type: proto.ColumnType_STRING, Description: "Unique role identifier inside the appRoles collection. This is the identifier referenced by the app_role_id column of app role assignments.", Transform: transform.FromMethod("GetId")},
+			{Name: "value", Type: proto.ColumnType_STRING, Description: "Specifies the value to include in the roles claim in ID tokens and access tokens authenticating an assigned user or service principal.", Transform: transform.FromMethod("GetValue")},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "Display name for the permission that appears in the app role assignment and consent experiences.", Transform: transform.FromMethod("GetDisplayName")},
+
+			// Other fields
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description for the app role. This is displayed when the app role is being assigned and, if the app role functions as an application permission, during consent experiences.", Transform: transform.FromMethod("GetDescription")},
+			{Name: "is_enabled", Type: proto.ColumnType_BOOL, Description: "When creating or updating an app role, this must be set to true (which is the default). To delete a role, this must first be set to false.", Transform: transform.FromMethod("GetIsEnabled")},
+
+			// JSON fields
+			{Name: "allowed_member_types", Type: proto.ColumnType_JSON, Description: "Specifies whether this app role can be assigned to users and groups (by setting to ['User']), to other application's (by setting to ['Application'], or both (by setting to ['User', 'Application']).", Transform: transform.FromMethod("GetAllowedMemberTypes")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adAppRoleTitle)},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdAppRoles(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	resourceId := d.EqualsQuals["resource_id"].GetStringValue()
+	if resourceId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_app_role.listAdAppRoles", "connection_error", err)
+		return nil, err
+	}
+
+	// The app roles are part of the service principal object, so only that property is requested
+	options := &serviceprincipals.ServicePrincipalItemRequestBuilderGetRequestConfiguration{
+		QueryParameters: &serviceprincipals.ServicePrincipalItemRequestBuilderGetQueryParameters{
+			Select: []string{"id", "appRoles"},
+		},
+	}
+
+	servicePrincipal, err := client.ServicePrincipals().ByServicePrincipalId(resourceId).Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdAppRoles", "get_service_principal_error", errObj)
+		return nil, errObj
+	}
+
+	for _, appRole := range servicePrincipal.GetAppRoles() {
+		d.StreamListItem(ctx, &ADAppRoleInfo{appRole, &resourceId})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func adAppRoleTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADAppRoleInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	title := data.GetDisplayName()
+	if title == nil {
+		title = data.GetValue()
+	}
+
+	return title, nil
+}
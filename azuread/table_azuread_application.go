@@ -7,7 +7,6 @@ import (
 
 	"github.com/iancoleman/strcase"
 	abstractions "github.com/microsoft/kiota-abstractions-go"
-	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
 	"github.com/microsoftgraph/msgraph-sdk-go/applications"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 
@@ -34,7 +33,8 @@ func tableAzureAdApplication(_ context.Context) *plugin.Table {
 			KeyColumns: plugin.KeyColumnSlice{
 				// Key fields
 				{Name: "app_id", Require: plugin.Optional},
-				{Name: "display_name", Require: plugin.Optional},
+				{Name: "display_name", Require: plugin.Optional, Operators: []string{"=", "~~", "~~*"}},
+				{Name: "identifier_uri", Require: plugin.Optional},
 				{Name: "publisher_domain", Require: plugin.Optional},
 			},
 		},
@@ -43,26 +43,38 @@ func tableAzureAdApplication(_ context.Context) *plugin.Table {
 			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name for the application.", Transform: transform.FromMethod("GetDisplayName")},
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier for the application.", Transform: transform.FromMethod("GetId")},
 			{Name: "app_id", Type: proto.ColumnType_STRING, Description: "The unique identifier for the application that is assigned to an application by Azure AD.", Transform: transform.FromMethod("GetAppId")},
+			{Name: "identifier_uri", Type: proto.ColumnType_STRING, Description: "Filter column to look up the application by one of its identifier_uris, e.g. api://contoso-api. Only set when the query filters on it, use identifier_uris for the URIs of the application.", Transform: transform.FromQual("identifier_uri")},
 
 			// Other fields
 			{Name: "created_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the application was registered. The DateTimeOffset type represents date and time information using ISO 8601 format and is always in UTC time.", Transform: transform.FromMethod("GetCreatedDateTime")},
 			{Name: "description", Type: proto.ColumnType_STRING, Description: "Free text field to provide a description of the application object to end users.", Transform: transform.FromMethod("GetDescription")},
+			{Name: "disabled_by_microsoft_status", Type: proto.ColumnType_STRING, Description: "Whether Microsoft has disabled the application, e.g. for a suspicious or abusive activity. Possible values are: null (default value), NotDisabled, and DisabledDueToViolationOfServicesAgreement.", Transform: transform.FromMethod("GetDisabledByMicrosoftStatus")},
 			{Name: "is_authorization_service_enabled", Type: proto.ColumnType_BOOL, Description: "Is authorization service enabled.", Default: false},
+			{Name: "is_fallback_public_client", Type: proto.ColumnType_BOOL, Description: "True if the application is a public client, e.g. an installed application running on a mobile device, when the type of the client can't be determined. This is the Allow public client flows setting of the portal, which enables the ROPC and the device code flows.", Transform: transform.FromMethod("GetIsFallbackPublicClient"), Default: false},
+			{Name: "allow_public_client_flows", Type: proto.ColumnType_BOOL, Description: "True if the application can authenticate as a public client, without a secret or a certificate, i.e. if is_fallback_public_client is true or if public client redirect URIs are configured.", Transform: transform.FromMethod("ApplicationAllowPublicClientFlows")},
+			{Name: "notes", Type: proto.ColumnType_STRING, Description: "Free text field to capture information about the application, e.g. its owning team, typically used for operational purposes.", Transform: transform.FromMethod("GetNotes")},
 			{Name: "oauth2_require_post_response", Type: proto.ColumnType_BOOL, Description: "Specifies whether, as part of OAuth 2.0 token requests, Azure AD allows POST requests, as opposed to GET requests. The default is false, which specifies that only GET requests are allowed.", Transform: transform.FromMethod("GetOauth2RequirePostResponse"), Default: false},
+			{Name: "group_membership_claims", Type: proto.ColumnType_STRING, Description: "Configures the groups claim issued in a user or OAuth 2.0 access token that the application expects. Possible values are: None, SecurityGroup, All, DirectoryRole, ApplicationGroup.", Transform: transform.FromMethod("GetGroupMembershipClaims")},
+			{Name: "app_owner_organization_id", Type: proto.ColumnType_STRING, Description: "The tenant id where the application is registered, read from the service principal of the application in this tenant. Null if the application has no service principal.", Hydrate: getAdApplicationOwnerOrganizationId, Transform: transform.FromValue()},
 			{Name: "publisher_domain", Type: proto.ColumnType_STRING, Description: "The verified publisher domain for the application.", Transform: transform.FromMethod("GetPublisherDomain")},
+			{Name: "service_management_reference", Type: proto.ColumnType_STRING, Description: "A reference to the application or the service contact information in a service or asset management database.", Transform: transform.FromMethod("GetServiceManagementReference")},
 			{Name: "sign_in_audience", Type: proto.ColumnType_STRING, Description: "Specifies the Microsoft accounts that are supported for the current application.", Transform: transform.FromMethod("GetSignInAudience")},
+			{Name: "token_encryption_key_id", Type: proto.ColumnType_STRING, Description: "Specifies the keyId of a public key from the key_credentials collection. When configured, Azure AD encrypts all the tokens it emits by using the key this property points to.", Transform: transform.FromMethod("ApplicationTokenEncryptionKeyId")},
 
 			// JSON fields
 			{Name: "api", Type: proto.ColumnType_JSON, Description: "Specifies settings for an application that implements a web API.", Transform: transform.FromMethod("ApplicationAPI")},
 			{Name: "identifier_uris", Type: proto.ColumnType_JSON, Description: "The URIs that identify the application within its Azure AD tenant, or within a verified custom domain if the application is multi-tenant.", Transform: transform.FromMethod("GetIdentifierUris")},
 			{Name: "info", Type: proto.ColumnType_JSON, Description: "Basic profile information of the application such as app's marketing, support, terms of service and privacy statement URLs. The terms of service and privacy statement are surfaced to users through the user consent experience.", Transform: transform.FromMethod("ApplicationInfo")},
 			{Name: "key_credentials", Type: proto.ColumnType_JSON, Description: "The collection of key credentials associated with the application.", Transform: transform.FromMethod("ApplicationKeyCredentials")},
+			{Name: "optional_claims", Type: proto.ColumnType_JSON, Description: "The optional claims the application requests in its access, ID and SAML tokens.", Transform: transform.FromMethod("ApplicationOptionalClaims")},
 			{Name: "owner_ids", Type: proto.ColumnType_JSON, Hydrate: getAdApplicationOwners, Transform: transform.FromValue(), Description: "Id of the owners of the application. The owners are a set of non-admin users who are allowed to modify this object."},
 			{Name: "parental_control_settings", Type: proto.ColumnType_JSON, Description: "Specifies parental control settings for an application.", Transform: transform.FromMethod("ApplicationParentalControlSettings")},
 			{Name: "password_credentials", Type: proto.ColumnType_JSON, Description: "The collection of password credentials associated with the application.", Transform: transform.FromMethod("ApplicationPasswordCredentials")},
+			{Name: "public_client_redirect_uris", Type: proto.ColumnType_JSON, Description: "The redirect URIs of the public client platform (mobile and desktop applications), where the authorization codes and the access tokens are sent.", Transform: transform.FromMethod("ApplicationPublicClientRedirectUris")},
 			{Name: "spa", Type: proto.ColumnType_JSON, Description: "Specifies settings for a single-page application, including sign out URLs and redirect URIs for authorization codes and access tokens.", Transform: transform.FromMethod("ApplicationSpa")},
 			{Name: "tags_src", Type: proto.ColumnType_JSON, Description: "Custom strings that can be used to categorize and identify the application.", Transform: transform.FromMethod("GetTags")},
 			{Name: "web", Type: proto.ColumnType_JSON, Description: "Specifies settings for a web application.", Transform: transform.FromMethod("ApplicationWeb")},
+			rawColumn(),
 
 			// Standard columns
 			{Name: "tags", Type: proto.ColumnType_JSON, Description: ColumnDescriptionTags, Transform: transform.From(adApplicationTags)},
@@ -111,8 +123,16 @@ func listAdApplications(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 		input.Filter = &joinStr
 	}
 
+	// A like or ilike qual on display_name is sent to Graph as a $search, which requires the ConsistencyLevel header
+	headers := &abstractions.RequestHeaders{}
+	if search := buildDisplayNameSearch(d.Quals); search != "" {
+		input.Search = &search
+		headers.Add("ConsistencyLevel", "eventual")
+	}
+
 	options := &applications.ApplicationsRequestBuilderGetRequestConfiguration{
 		QueryParameters: input,
+		Headers:         headers,
 	}
 
 	result, err := client.Applications().Get(ctx, options)
@@ -122,13 +142,8 @@ func listAdApplications(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 		return nil, errObj
 	}
 
-	pageIterator, err := msgraphcore.NewPageIterator[models.Applicationable](result, adapter, models.CreateApplicationCollectionResponseFromDiscriminatorValue)
-	if err != nil {
-		plugin.Logger(ctx).Error("listAdApplications", "create_iterator_instance_error", err)
-		return nil, err
-	}
-
-	err = pageIterator.Iterate(ctx, func(pageItem models.Applicationable) bool {
+	// The next page requests must carry the same headers as the first one
+	err = iteratePages(ctx, d, adapter, result, models.CreateApplicationCollectionResponseFromDiscriminatorValue, headers, func(pageItem models.Applicationable) bool {
 		isAuthorizationServiceEnabled := pageItem.GetAdditionalData()["isAuthorizationServiceEnabled"]
 
 		d.StreamListItem(ctx, &ADApplicationInfo{pageItem, isAuthorizationServiceEnabled})
@@ -202,13 +217,7 @@ func getAdApplicationOwners(ctx context.Context, d *plugin.QueryData, h *plugin.
 		return nil, errObj
 	}
 
-	pageIterator, err := msgraphcore.NewPageIterator[models.DirectoryObjectable](owners, adapter, models.CreateDirectoryObjectCollectionResponseFromDiscriminatorValue)
-	if err != nil {
-		plugin.Logger(ctx).Error("getAdApplicationOwners", "create_iterator_instance_error", err)
-		return nil, err
-	}
-
-	err = pageIterator.Iterate(ctx, func(pageItem models.DirectoryObjectable) bool {
+	err = iteratePages(ctx, d, adapter, owners, models.CreateDirectoryObjectCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.DirectoryObjectable) bool {
 		ownerIds = append(ownerIds, pageItem.GetId())
 
 		return true
@@ -220,6 +229,24 @@ func getAdApplicationOwners(ctx context.Context, d *plugin.QueryData, h *plugin.
 	return ownerIds, nil
 }
 
+func getAdApplicationOwnerOrganizationId(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	application := h.Item.(*ADApplicationInfo)
+	if application.GetAppId() == nil {
+		return nil, nil
+	}
+
+	// The owner organization is only exposed on the service principals, which a multi-tenant application also has in its home tenant
+	servicePrincipal, err := getServicePrincipalByAppId(ctx, d, *application.GetAppId())
+	if err != nil {
+		return nil, err
+	}
+	if servicePrincipal == nil {
+		return nil, nil
+	}
+
+	return servicePrincipal.GetAppOwnerOrganizationId(), nil
+}
+
 //// TRANSFORM FUNCTIONS
 
 func adApplicationTags(ctx context.Context, d *transform.TransformData) (interface{}, error) {
@@ -253,9 +280,14 @@ func buildApplicationQueryFilter(equalQuals plugin.KeyColumnEqualsQualMap) []str
 
 	for qual := range filterQuals {
 		if equalQuals[qual] != nil {
-			filters = append(filters, fmt.Sprintf("%s eq '%s'", strcase.ToCamel(qual), equalQuals[qual].GetStringValue()))
+			filters = append(filters, fmt.Sprintf("%s eq '%s'", strcase.ToCamel(qual), escapeODataString(equalQuals[qual].GetStringValue())))
 		}
 	}
 
+	// identifierUris is a collection, so it is matched with a lambda operator
+	if equalQuals["identifier_uri"] != nil {
+		filters = append(filters, fmt.Sprintf("identifierUris/any(x:x eq '%s')", escapeODataString(equalQuals["identifier_uri"].GetStringValue())))
+	}
+
 	return filters
 }
@@ -10,7 +10,6 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
-	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 	"github.com/microsoftgraph/msgraph-sdk-go/serviceprincipals"
 )
@@ -120,13 +119,7 @@ func listAdApplicationAppRoleAssignedTo(ctx context.Context, d *plugin.QueryData
 		return nil, errObj
 	}
 
-	pageIterator, err := msgraphcore.NewPageIterator[models.AppRoleAssignmentable](result, adapter, models.CreateAppRoleAssignmentCollectionResponseFromDiscriminatorValue)
-	if err != nil {
-		plugin.Logger(ctx).Error("listAdApplicationAppRoleAssignedTo", "create_iterator_instance_error", err)
-		return nil, err
-	}
-
-	err = pageIterator.Iterate(ctx, func(pageItem models.AppRoleAssignmentable) bool {
+	err = iteratePages(ctx, d, adapter, result, models.CreateAppRoleAssignmentCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.AppRoleAssignmentable) bool {
 		d.StreamListItem(ctx, &ADApplicationAppRoleAssignmentInfo{pageItem, &applicationId})
 
 		// Context can be cancelled due to manual cancellation or the limit has been hit
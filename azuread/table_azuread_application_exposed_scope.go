@@ -0,0 +1,127 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/applications"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdApplicationExposedScope(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_application_exposed_scope",
+		Description: "Represents a delegated permission scope published by an application registration which exposes a web API, which other applications can request and be consented to.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdApplicationExposedScopes,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "app_object_id", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "app_object_id", Type: proto.ColumnType_STRING, Description: "The unique identifier (object ID) of the application which exposes the scope.", Transform: transform.FromField("AppObjectId")},
+			{Name: "app_id", Type: proto.ColumnType_STRING, Description: "The application (client) ID of the application which exposes the scope.", Transform: transform.FromField("AppId")},
+			{Name: "scope_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the scope.", Transform: transform.FromMethod("ApplicationExposedScopeId")},
+			{Name: "value", Type: proto.ColumnType_STRING, Description: "The value of the scope, included in the scp claim of the access tokens, e.g. Files.Read.", Transform: transform.FromMethod("GetValue")},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "Whether a non-admin user can consent to the scope. Possible values are: User, Admin.", Transform: transform.FromMethod("GetTypeEscaped")},
+			{Name: "is_enabled", Type: proto.ColumnType_BOOL, Description: "True if the scope can be requested. A scope must be disabled before it is removed.", Transform: transform.FromMethod("GetIsEnabled")},
+
+			// Other fields
+			{Name: "admin_consent_display_name", Type: proto.ColumnType_STRING, Description: "The title of the scope, shown to the administrators granting it on behalf of all users.", Transform: transform.FromMethod("GetAdminConsentDisplayName")},
+			{Name: "admin_consent_description", Type: proto.ColumnType_STRING, Description: "The description of the scope, shown to the administrators granting it on behalf of all users.", Transform: transform.FromMethod("GetAdminConsentDescription")},
+			{Name: "user_consent_display_name", Type: proto.ColumnType_STRING, Description: "The title of the scope, shown to the users consenting to it on their own behalf.", Transform: transform.FromMethod("GetUserConsentDisplayName")},
+			{Name: "user_consent_description", Type: proto.ColumnType_STRING, Description: "The description of the scope, shown to the users consenting to it on their own behalf.", Transform: transform.FromMethod("GetUserConsentDescription")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetValue")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdApplicationExposedScopes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_application_exposed_scope.listAdApplicationExposedScopes", "connection_error", err)
+		return nil, err
+	}
+
+	selectFields := []string{"id", "appId", "api"}
+
+	if d.EqualsQuals["app_object_id"] != nil {
+		appObjectId := d.EqualsQuals["app_object_id"].GetStringValue()
+		if appObjectId == "" {
+			return nil, nil
+		}
+
+		options := &applications.ApplicationItemRequestBuilderGetRequestConfiguration{
+			QueryParameters: &applications.ApplicationItemRequestBuilderGetQueryParameters{
+				Select: selectFields,
+			},
+		}
+
+		application, err := client.Applications().ByApplicationId(appObjectId).Get(ctx, options)
+		if err != nil {
+			errObj := getErrorObject(err)
+			plugin.Logger(ctx).Error("listAdApplicationExposedScopes", "get_application_error", errObj)
+			return nil, errObj
+		}
+
+		streamAdApplicationExposedScopes(ctx, d, application)
+		return nil, nil
+	}
+
+	// Without an application, the scopes exposed by all the applications are listed
+	options := &applications.ApplicationsRequestBuilderGetRequestConfiguration{
+		QueryParameters: &applications.ApplicationsRequestBuilderGetQueryParameters{
+			Top:    Int32(999),
+			Select: selectFields,
+		},
+	}
+
+	result, err := client.Applications().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdApplicationExposedScopes", "list_application_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateApplicationCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.Applicationable) bool {
+		return streamAdApplicationExposedScopes(ctx, d, pageItem)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdApplicationExposedScopes", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// streamAdApplicationExposedScopes streams a row per scope exposed by the application, and returns false once the limit has been hit
+func streamAdApplicationExposedScopes(ctx context.Context, d *plugin.QueryData, application models.Applicationable) bool {
+	if application.GetApi() == nil {
+		return true
+	}
+
+	for _, scope := range application.GetApi().GetOauth2PermissionScopes() {
+		d.StreamListItem(ctx, &ADApplicationExposedScopeInfo{scope, application.GetId(), application.GetAppId()})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
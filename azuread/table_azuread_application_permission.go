@@ -0,0 +1,267 @@
+package azuread
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/applications"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/oauth2permissiongrants"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdApplicationPermission(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_application_permission",
+		Description: "Represents an API permission requested by an application registration, with whether an admin consent has been granted for it.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdApplicationPermissions,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "application_id", Require: plugin.Required},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "application_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the application registration which requests the permission.", Transform: transform.FromField("ApplicationId")},
+			{Name: "app_id", Type: proto.ColumnType_STRING, Description: "The application (client) ID of the application which requests the permission.", Transform: transform.FromField("AppId")},
+			{Name: "permission_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the app role or of the delegated permission scope exposed by the resource application.", Transform: transform.FromField("PermissionId")},
+			{Name: "permission_type", Type: proto.ColumnType_STRING, Description: "The type of the permission. Possible values are: Scope (delegated permission), Role (application permission).", Transform: transform.FromField("PermissionType")},
+			{Name: "permission_value", Type: proto.ColumnType_STRING, Description: "The value of the permission, e.g. User.Read. Null if the resource application has no service principal in the tenant.", Transform: transform.FromField("PermissionValue")},
+			{Name: "consent_status", Type: proto.ColumnType_STRING, Description: "Whether an admin consent has been granted for the permission to the service principal of the application. Possible values are: granted, not_granted.", Transform: transform.FromField("ConsentStatus")},
+
+			// Other fields
+			{Name: "resource_app_id", Type: proto.ColumnType_STRING, Description: "The application (client) ID of the resource application which exposes the permission.", Transform: transform.FromField("ResourceAppId")},
+			{Name: "resource_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the service principal of the resource application.", Transform: transform.FromField("ResourceId")},
+			{Name: "resource_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the resource application.", Transform: transform.FromField("ResourceDisplayName")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adApplicationPermissionTitle)},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdApplicationPermissions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	applicationId := d.EqualsQuals["application_id"].GetStringValue()
+	if applicationId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_application_permission.listAdApplicationPermissions", "connection_error", err)
+		return nil, err
+	}
+
+	input := &applications.ApplicationItemRequestBuilderGetQueryParameters{
+		Select: []string{"id", "appId", "requiredResourceAccess"},
+	}
+
+	options := &applications.ApplicationItemRequestBuilderGetRequestConfiguration{
+		QueryParameters: input,
+	}
+
+	application, err := client.Applications().ByApplicationId(applicationId).Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdApplicationPermissions", "get_application_error", errObj)
+		return nil, errObj
+	}
+
+	if application.GetAppId() == nil || len(application.GetRequiredResourceAccess()) == 0 {
+		return nil, nil
+	}
+
+	// The consents are granted to the service principal of the application, which doesn't exist until the application is used in the tenant
+	grantedAppRoles := map[string]bool{}
+	grantedScopes := map[string]bool{}
+
+	servicePrincipal, err := getServicePrincipalByAppId(ctx, d, *application.GetAppId())
+	if err != nil {
+		return nil, err
+	}
+	if servicePrincipal != nil && servicePrincipal.GetId() != nil {
+		grantedAppRoles, err = getAdApplicationPermissionGrantedAppRoles(ctx, d, *servicePrincipal.GetId())
+		if err != nil {
+			return nil, err
+		}
+		grantedScopes, err = getAdApplicationPermissionGrantedScopes(ctx, d, *servicePrincipal.GetId())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, requiredResourceAccess := range application.GetRequiredResourceAccess() {
+		if requiredResourceAccess.GetResourceAppId() == nil {
+			continue
+		}
+
+		resource, err := getServicePrincipalByAppId(ctx, d, *requiredResourceAccess.GetResourceAppId())
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resourceAccess := range requiredResourceAccess.GetResourceAccess() {
+			if resourceAccess.GetId() == nil {
+				continue
+			}
+
+			permission := &ADApplicationPermissionInfo{
+				ApplicationId:  application.GetId(),
+				AppId:          application.GetAppId(),
+				ResourceAppId:  requiredResourceAccess.GetResourceAppId(),
+				PermissionId:   resourceAccess.GetId().String(),
+				PermissionType: resourceAccess.GetTypeEscaped(),
+				ConsentStatus:  "not_granted",
+			}
+
+			if resource != nil {
+				permission.ResourceId = resource.GetId()
+				permission.ResourceDisplayName = resource.GetDisplayName()
+				permission.PermissionValue = getAdApplicationPermissionValue(resource, permission)
+
+				if permission.ResourceId != nil {
+					switch {
+					case permission.PermissionType != nil && *permission.PermissionType == "Role":
+						if grantedAppRoles[*permission.ResourceId+"/"+permission.PermissionId] {
+							permission.ConsentStatus = "granted"
+						}
+					case permission.PermissionValue != nil:
+						if grantedScopes[*permission.ResourceId+"/"+*permission.PermissionValue] {
+							permission.ConsentStatus = "granted"
+						}
+					}
+				}
+			}
+
+			d.StreamListItem(ctx, permission)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// getAdApplicationPermissionGrantedAppRoles returns the app roles assigned to the service principal, keyed by "<resource id>/<app role id>".
+func getAdApplicationPermissionGrantedAppRoles(ctx context.Context, d *plugin.QueryData, servicePrincipalId string) (map[string]bool, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_application_permission.getAdApplicationPermissionGrantedAppRoles", "connection_error", err)
+		return nil, err
+	}
+
+	result, err := client.ServicePrincipals().ByServicePrincipalId(servicePrincipalId).AppRoleAssignments().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getAdApplicationPermissionGrantedAppRoles", "list_app_role_assignment_error", errObj)
+		return nil, errObj
+	}
+
+	grantedAppRoles := map[string]bool{}
+	err = iteratePages(ctx, d, adapter, result, models.CreateAppRoleAssignmentCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.AppRoleAssignmentable) bool {
+		if pageItem.GetResourceId() != nil && pageItem.GetAppRoleId() != nil {
+			grantedAppRoles[pageItem.GetResourceId().String()+"/"+pageItem.GetAppRoleId().String()] = true
+		}
+		return true
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("getAdApplicationPermissionGrantedAppRoles", "paging_error", err)
+		return nil, err
+	}
+
+	return grantedAppRoles, nil
+}
+
+// getAdApplicationPermissionGrantedScopes returns the delegated permissions granted to the service principal on behalf of all the users, keyed by "<resource id>/<scope>".
+func getAdApplicationPermissionGrantedScopes(ctx context.Context, d *plugin.QueryData, servicePrincipalId string) (map[string]bool, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_application_permission.getAdApplicationPermissionGrantedScopes", "connection_error", err)
+		return nil, err
+	}
+
+	// Only the grants made by an admin apply to all the users, the other ones are the consents of individual users
+	filter := fmt.Sprintf("clientId eq '%s' and consentType eq 'AllPrincipals'", servicePrincipalId)
+	options := &oauth2permissiongrants.Oauth2PermissionGrantsRequestBuilderGetRequestConfiguration{
+		QueryParameters: &oauth2permissiongrants.Oauth2PermissionGrantsRequestBuilderGetQueryParameters{
+			Filter: &filter,
+		},
+	}
+
+	result, err := client.Oauth2PermissionGrants().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getAdApplicationPermissionGrantedScopes", "list_oauth2_permission_grant_error", errObj)
+		return nil, errObj
+	}
+
+	grantedScopes := map[string]bool{}
+	err = iteratePages(ctx, d, adapter, result, models.CreateOAuth2PermissionGrantCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.OAuth2PermissionGrantable) bool {
+		if pageItem.GetResourceId() != nil && pageItem.GetScope() != nil {
+			// The scope is a space separated list of the granted permission values
+			for _, scope := range strings.Fields(*pageItem.GetScope()) {
+				grantedScopes[*pageItem.GetResourceId()+"/"+scope] = true
+			}
+		}
+		return true
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("getAdApplicationPermissionGrantedScopes", "paging_error", err)
+		return nil, err
+	}
+
+	return grantedScopes, nil
+}
+
+// getAdApplicationPermissionValue resolves the value of a requested permission from the app roles or the delegated permission scopes published by the resource.
+func getAdApplicationPermissionValue(resource models.ServicePrincipalable, permission *ADApplicationPermissionInfo) *string {
+	if permission.PermissionType != nil && *permission.PermissionType == "Role" {
+		for _, appRole := range resource.GetAppRoles() {
+			if appRole.GetId() != nil && appRole.GetId().String() == permission.PermissionId {
+				return appRole.GetValue()
+			}
+		}
+		return nil
+	}
+
+	for _, scope := range resource.GetOauth2PermissionScopes() {
+		if scope.GetId() != nil && scope.GetId().String() == permission.PermissionId {
+			return scope.GetValue()
+		}
+	}
+	return nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func adApplicationPermissionTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADApplicationPermissionInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	if data.PermissionValue != nil {
+		return data.PermissionValue, nil
+	}
+
+	return data.PermissionId, nil
+}
@@ -0,0 +1,128 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/applications"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdApplicationRedirectUri(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_application_redirect_uri",
+		Description: "Represents a redirect URI of an application registration, for the web, single-page application or public client platform.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdApplicationRedirectUris,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "app_object_id", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "app_object_id", Type: proto.ColumnType_STRING, Description: "The unique identifier (object ID) of the application.", Transform: transform.FromField("AppObjectId")},
+			{Name: "app_id", Type: proto.ColumnType_STRING, Description: "The application (client) ID of the application.", Transform: transform.FromField("AppId")},
+			{Name: "platform", Type: proto.ColumnType_STRING, Description: "The platform of the redirect URI. Possible values are: web, spa, publicClient.", Transform: transform.FromField("Platform")},
+			{Name: "uri", Type: proto.ColumnType_STRING, Description: "The redirect URI, where the user tokens are sent for sign-in.", Transform: transform.FromField("Uri")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromField("Uri")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdApplicationRedirectUris(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_application_redirect_uri.listAdApplicationRedirectUris", "connection_error", err)
+		return nil, err
+	}
+
+	selectFields := []string{"id", "appId", "web", "spa", "publicClient"}
+
+	if d.EqualsQuals["app_object_id"] != nil {
+		appObjectId := d.EqualsQuals["app_object_id"].GetStringValue()
+		if appObjectId == "" {
+			return nil, nil
+		}
+
+		options := &applications.ApplicationItemRequestBuilderGetRequestConfiguration{
+			QueryParameters: &applications.ApplicationItemRequestBuilderGetQueryParameters{
+				Select: selectFields,
+			},
+		}
+
+		application, err := client.Applications().ByApplicationId(appObjectId).Get(ctx, options)
+		if err != nil {
+			errObj := getErrorObject(err)
+			plugin.Logger(ctx).Error("listAdApplicationRedirectUris", "get_application_error", errObj)
+			return nil, errObj
+		}
+
+		streamAdApplicationRedirectUris(ctx, d, application)
+		return nil, nil
+	}
+
+	// Without an application, the redirect URIs of all the applications are listed
+	options := &applications.ApplicationsRequestBuilderGetRequestConfiguration{
+		QueryParameters: &applications.ApplicationsRequestBuilderGetQueryParameters{
+			Top:    Int32(999),
+			Select: selectFields,
+		},
+	}
+
+	result, err := client.Applications().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdApplicationRedirectUris", "list_application_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateApplicationCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.Applicationable) bool {
+		return streamAdApplicationRedirectUris(ctx, d, pageItem)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdApplicationRedirectUris", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// streamAdApplicationRedirectUris streams a row per redirect URI of the application, and returns false once the limit has been hit
+func streamAdApplicationRedirectUris(ctx context.Context, d *plugin.QueryData, application models.Applicationable) bool {
+	redirectUris := map[string][]string{}
+	if application.GetWeb() != nil {
+		redirectUris["web"] = application.GetWeb().GetRedirectUris()
+	}
+	if application.GetSpa() != nil {
+		redirectUris["spa"] = application.GetSpa().GetRedirectUris()
+	}
+	if application.GetPublicClient() != nil {
+		redirectUris["publicClient"] = application.GetPublicClient().GetRedirectUris()
+	}
+
+	for _, platform := range []string{"web", "spa", "publicClient"} {
+		for _, uri := range redirectUris[platform] {
+			d.StreamListItem(ctx, &ADApplicationRedirectUriInfo{application.GetId(), application.GetAppId(), platform, uri})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
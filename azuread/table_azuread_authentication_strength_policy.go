@@ -0,0 +1,94 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdAuthenticationStrengthPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_authentication_strength_policy",
+		Description: "Represents an authentication strength, which defines the combinations of authentication methods that can be used to satisfy a conditional access policy.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdAuthenticationStrengthPolicies,
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The system-generated identifier for this authentication strength policy.", Transform: transform.FromMethod("GetId")},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The human-readable display name of this policy.", Transform: transform.FromMethod("GetDisplayName")},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The human-readable description of this policy.", Transform: transform.FromMethod("GetDescription")},
+			{Name: "policy_type", Type: proto.ColumnType_STRING, Description: "A descriptor of whether this policy is built into Microsoft Entra Conditional Access or created by an admin for the tenant. Possible values are: builtIn, custom.", Transform: transform.FromMethod("AuthenticationStrengthPolicyPolicyType")},
+
+			// Other fields
+			{Name: "requirements_satisfied", Type: proto.ColumnType_STRING, Description: "A descriptor of whether this authentication strength grants the MFA claim upon successful satisfaction. Possible values are: none, mfa.", Transform: transform.FromMethod("AuthenticationStrengthPolicyRequirementsSatisfied")},
+			{Name: "created_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The datetime when this policy was created.", Transform: transform.FromMethod("GetCreatedDateTime")},
+			{Name: "modified_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The datetime when this policy was last modified.", Transform: transform.FromMethod("GetModifiedDateTime")},
+
+			// JSON fields
+			{Name: "allowed_combinations", Type: proto.ColumnType_JSON, Description: "A collection of authentication method modes that can be used to satisfy this authentication strength, e.g. \"password,microsoftAuthenticatorPush\" or \"fido2\".", Transform: transform.FromMethod("AuthenticationStrengthPolicyAllowedCombinations")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetDisplayName")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdAuthenticationStrengthPolicies(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	policies, err := getAuthenticationStrengthPoliciesMemoized(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, policy := range policies.([]models.AuthenticationStrengthPolicyable) {
+		d.StreamListItem(ctx, &ADAuthenticationStrengthPolicyInfo{policy})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// The authentication strengths are a small set which rarely changes, so they are fetched once per connection
+var getAuthenticationStrengthPoliciesMemoized = plugin.HydrateFunc(getAuthenticationStrengthPoliciesUncached).Memoize()
+
+func getAuthenticationStrengthPoliciesUncached(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_authentication_strength_policy.getAuthenticationStrengthPoliciesUncached", "connection_error", err)
+		return nil, err
+	}
+
+	result, err := client.Policies().AuthenticationStrengthPolicies().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getAuthenticationStrengthPoliciesUncached", "list_authentication_strength_policy_error", errObj)
+		return nil, errObj
+	}
+
+	policies := []models.AuthenticationStrengthPolicyable{}
+	err = iteratePages(ctx, d, adapter, result, models.CreateAuthenticationStrengthPolicyCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.AuthenticationStrengthPolicyable) bool {
+		policies = append(policies, pageItem)
+		return true
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("getAuthenticationStrengthPoliciesUncached", "paging_error", err)
+		return nil, err
+	}
+
+	return policies, nil
+}
@@ -0,0 +1,93 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdB2CIdentityProvider(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_b2c_identity_provider",
+		Description: "Represents a social identity provider, such as Google or Facebook, configured in an Azure AD B2C tenant.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdB2CIdentityProviders,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				// The tenants which aren't B2C tenants may reject the request
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"B2C"}),
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The identifier of the identity provider.", Transform: transform.FromMethod("GetId")},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name of the identity provider.", Transform: transform.FromMethod("GetDisplayName")},
+			{Name: "identity_provider_type", Type: proto.ColumnType_STRING, Description: "The type of the identity provider. For a B2C tenant, possible values are: Microsoft, Google, Amazon, LinkedIn, Facebook, GitHub, Twitter, Weibo, QQ, WeChat.", Transform: transform.FromMethod("GetIdentityProviderType")},
+
+			// Other fields
+			{Name: "client_id", Type: proto.ColumnType_STRING, Description: "The identifier for the client application obtained when registering the application with the identity provider.", Transform: transform.FromMethod("GetClientId")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adB2CIdentityProviderTitle)},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdB2CIdentityProviders(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_b2c_identity_provider.listAdB2CIdentityProviders", "connection_error", err)
+		return nil, err
+	}
+
+	// The API doesn't support the $top query parameter, so the page size is left to the service
+	result, err := client.Identity().IdentityProviders().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdB2CIdentityProviders", "list_identity_provider_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateIdentityProviderBaseCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.IdentityProviderBaseable) bool {
+		// Only the social identity providers are configured by the admins, the built-in ones are available in every tenant
+		socialIdentityProvider, ok := pageItem.(models.SocialIdentityProviderable)
+		if !ok {
+			return true
+		}
+
+		d.StreamListItem(ctx, &ADB2CIdentityProviderInfo{socialIdentityProvider})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdB2CIdentityProviders", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func adB2CIdentityProviderTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADB2CIdentityProviderInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	title := data.GetDisplayName()
+	if title == nil {
+		title = data.GetId()
+	}
+
+	return title, nil
+}
@@ -0,0 +1,88 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdB2CUserAttribute(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_b2c_user_attribute",
+		Description: "Represents a user attribute, built-in or custom, which can be collected by the user flows of an Azure AD B2C tenant.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdB2CUserAttributes,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				// The user flow attributes are only available in B2C tenants
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"B2C"}),
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The identifier of the user flow attribute.", Transform: transform.FromMethod("GetId")},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name of the user flow attribute.", Transform: transform.FromMethod("GetDisplayName")},
+			{Name: "data_type", Type: proto.ColumnType_STRING, Description: "The data type of the user flow attribute. Possible values are: string, boolean, int64, stringCollection, dateTime.", Transform: transform.FromMethod("B2CUserAttributeDataType")},
+			{Name: "user_flow_attribute_type", Type: proto.ColumnType_STRING, Description: "The type of the user flow attribute. Possible values are: builtIn, custom, required.", Transform: transform.FromMethod("B2CUserAttributeUserFlowAttributeType")},
+
+			// Other fields
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the user flow attribute that's shown to the user at the time of sign-up.", Transform: transform.FromMethod("GetDescription")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adB2CUserAttributeTitle)},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdB2CUserAttributes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_b2c_user_attribute.listAdB2CUserAttributes", "connection_error", err)
+		return nil, err
+	}
+
+	// The API doesn't support the $top query parameter, so the page size is left to the service
+	result, err := client.Identity().UserFlowAttributes().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdB2CUserAttributes", "list_user_flow_attribute_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateIdentityUserFlowAttributeCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.IdentityUserFlowAttributeable) bool {
+		d.StreamListItem(ctx, &ADB2CUserAttributeInfo{pageItem})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdB2CUserAttributes", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func adB2CUserAttributeTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADB2CUserAttributeInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	title := data.GetDisplayName()
+	if title == nil {
+		title = data.GetId()
+	}
+
+	return title, nil
+}
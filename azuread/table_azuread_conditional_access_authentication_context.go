@@ -0,0 +1,102 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdConditionalAccessAuthenticationContext(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_conditional_access_authentication_context",
+		Description: "Represents an authentication context, which lets conditional access policies protect specific actions or data within applications.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdConditionalAccessAuthenticationContexts,
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The identifier of the authentication context class reference, from c1 to c99.", Transform: transform.FromMethod("GetId")},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name of the authentication context class reference.", Transform: transform.FromMethod("GetDisplayName")},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "A short explanation of the policies that are enforced by the authentication context class reference.", Transform: transform.FromMethod("GetDescription")},
+			{Name: "is_available", Type: proto.ColumnType_BOOL, Description: "Indicates whether the authentication context class reference is published, and can be used by the applications.", Transform: transform.FromMethod("GetIsAvailable")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adConditionalAccessAuthenticationContextTitle)},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdConditionalAccessAuthenticationContexts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	authenticationContexts, err := getAuthenticationContextClassReferencesMemoized(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, authenticationContext := range authenticationContexts.([]models.AuthenticationContextClassReferenceable) {
+		d.StreamListItem(ctx, &ADAuthenticationContextClassReferenceInfo{authenticationContext})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// The authentication contexts are a small catalog of at most 99 entries, so they are fetched once per connection
+var getAuthenticationContextClassReferencesMemoized = plugin.HydrateFunc(getAuthenticationContextClassReferencesUncached).Memoize()
+
+func getAuthenticationContextClassReferencesUncached(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_conditional_access_authentication_context.getAuthenticationContextClassReferencesUncached", "connection_error", err)
+		return nil, err
+	}
+
+	result, err := client.Identity().ConditionalAccess().AuthenticationContextClassReferences().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getAuthenticationContextClassReferencesUncached", "list_authentication_context_class_reference_error", errObj)
+		return nil, errObj
+	}
+
+	authenticationContexts := []models.AuthenticationContextClassReferenceable{}
+	err = iteratePages(ctx, d, adapter, result, models.CreateAuthenticationContextClassReferenceCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.AuthenticationContextClassReferenceable) bool {
+		authenticationContexts = append(authenticationContexts, pageItem)
+		return true
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("getAuthenticationContextClassReferencesUncached", "paging_error", err)
+		return nil, err
+	}
+
+	return authenticationContexts, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func adConditionalAccessAuthenticationContextTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADAuthenticationContextClassReferenceInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	title := data.GetDisplayName()
+	if title == nil {
+		title = data.GetId()
+	}
+
+	return title, nil
+}
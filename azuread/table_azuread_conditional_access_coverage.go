@@ -0,0 +1,177 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/turbot/go-kit/helpers"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// globalAdministratorRoleTemplateId is the template ID of the Global Administrator built-in directory role
+const globalAdministratorRoleTemplateId = "62e90394-69f5-4237-9190-012177145e10"
+
+// conditionalAccessCoverageScenarios are the protections checked against the conditional access policies. A policy covers a scenario
+// when it applies to all the cloud apps without excluding any of them, and matches the scenario. Except for admin_mfa, the users excluded
+// from a covering policy are tolerated, as the emergency access (break-glass) accounts must be excluded, but they are reported so they
+// can be reviewed.
+var conditionalAccessCoverageScenarios = []struct {
+	name        string
+	description string
+	matches     func(policy *ADConditionalAccessPolicyInfo) bool
+}{
+	{
+		name:        "all_users_mfa",
+		description: "MFA is required for all the users on all the cloud apps, and no highly privileged role is excluded.",
+		matches: func(policy *ADConditionalAccessPolicyInfo) bool {
+			return policy.ConditionalAccessPolicyAppliesToAllUsers() && !policy.ConditionalAccessPolicyExcludesPrivilegedRoles() && policy.ConditionalAccessPolicyRequiresMfa()
+		},
+	},
+	{
+		name:        "admin_mfa",
+		description: "MFA is required for the administrators on all the cloud apps, either by a policy for all the users or by a policy including the Global Administrator role, which doesn't exclude any highly privileged role, user or group.",
+		matches: func(policy *ADConditionalAccessPolicyInfo) bool {
+			if !policy.ConditionalAccessPolicyRequiresMfa() || policy.ConditionalAccessPolicyExcludesPrivilegedRoles() {
+				return false
+			}
+
+			// An excluded user or group may hold an administrator role, so it is a gap
+			users := policy.GetConditions().GetUsers()
+			if len(users.GetExcludeUsers()) > 0 || len(users.GetExcludeGroups()) > 0 {
+				return false
+			}
+
+			return policy.ConditionalAccessPolicyAppliesToAllUsers() || helpers.StringSliceContains(users.GetIncludeRoles(), globalAdministratorRoleTemplateId)
+		},
+	},
+	{
+		name:        "legacy_auth_blocked",
+		description: "The legacy authentication clients, i.e. Exchange ActiveSync and the other clients, are blocked for all the users on all the cloud apps.",
+		matches: func(policy *ADConditionalAccessPolicyInfo) bool {
+			if !policy.ConditionalAccessPolicyAppliesToAllUsers() || policy.GetGrantControls() == nil {
+				return false
+			}
+
+			clientAppTypes := []string{}
+			for _, clientAppType := range policy.GetConditions().GetClientAppTypes() {
+				clientAppTypes = append(clientAppTypes, clientAppType.String())
+			}
+			if !helpers.StringSliceContains(clientAppTypes, "exchangeActiveSync") || !helpers.StringSliceContains(clientAppTypes, "other") {
+				return false
+			}
+
+			for _, control := range policy.GetGrantControls().GetBuiltInControls() {
+				if control.String() == "block" {
+					return true
+				}
+			}
+			return false
+		},
+	},
+}
+
+//// TABLE DEFINITION
+
+func tableAzureAdConditionalAccessCoverage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_conditional_access_coverage",
+		Description: "Represents a protection expected from the conditional access policies, such as MFA for all the users, with whether an enabled policy covers it.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdConditionalAccessCoverages,
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "scenario", Type: proto.ColumnType_STRING, Description: "The protection checked. Possible values are: all_users_mfa, admin_mfa, legacy_auth_blocked.", Transform: transform.FromField("Scenario")},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The conditions a policy must meet to cover the scenario.", Transform: transform.FromField("Description")},
+			{Name: "covered", Type: proto.ColumnType_BOOL, Description: "True if at least one enabled policy covers the scenario.", Transform: transform.FromField("Covered")},
+
+			// JSON fields
+			{Name: "enforcing_policy_ids", Type: proto.ColumnType_JSON, Description: "The IDs of the enabled policies which cover the scenario.", Transform: transform.FromField("EnforcingPolicyIds")},
+			{Name: "report_only_policy_ids", Type: proto.ColumnType_JSON, Description: "The IDs of the report-only policies which would cover the scenario once enabled.", Transform: transform.FromField("ReportOnlyPolicyIds")},
+			{Name: "excluded_principal_ids", Type: proto.ColumnType_JSON, Description: "The IDs of the users and the groups excluded from the enabled policies covering the scenario, which should only be the emergency access accounts.", Transform: transform.FromField("ExcludedPrincipalIds")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromField("Scenario")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdConditionalAccessCoverages(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_conditional_access_coverage.listAdConditionalAccessCoverages", "connection_error", err)
+		return nil, err
+	}
+
+	result, err := client.Identity().ConditionalAccess().Policies().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdConditionalAccessCoverages", "list_conditional_access_policy_error", errObj)
+		return nil, errObj
+	}
+
+	// The whole policy set is needed to evaluate the scenarios
+	policies := []*ADConditionalAccessPolicyInfo{}
+	err = iteratePages(ctx, d, adapter, result, models.CreateConditionalAccessPolicyCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.ConditionalAccessPolicyable) bool {
+		policies = append(policies, &ADConditionalAccessPolicyInfo{pageItem})
+		return true
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdConditionalAccessCoverages", "paging_error", err)
+		return nil, err
+	}
+
+	for _, scenario := range conditionalAccessCoverageScenarios {
+		coverage := &ADConditionalAccessCoverageInfo{
+			Scenario:             scenario.name,
+			Description:          scenario.description,
+			EnforcingPolicyIds:   []string{},
+			ReportOnlyPolicyIds:  []string{},
+			ExcludedPrincipalIds: []string{},
+		}
+
+		for _, policy := range policies {
+			if !conditionalAccessPolicyAppliesToAllApps(policy) || !scenario.matches(policy) {
+				continue
+			}
+
+			switch policy.ConditionalAccessPolicyState() {
+			case "enabled":
+				coverage.EnforcingPolicyIds = append(coverage.EnforcingPolicyIds, *policy.GetId())
+				users := policy.GetConditions().GetUsers()
+				for _, principalId := range append(users.GetExcludeUsers(), users.GetExcludeGroups()...) {
+					if !helpers.StringSliceContains(coverage.ExcludedPrincipalIds, principalId) {
+						coverage.ExcludedPrincipalIds = append(coverage.ExcludedPrincipalIds, principalId)
+					}
+				}
+			case "enabledForReportingButNotEnforced":
+				coverage.ReportOnlyPolicyIds = append(coverage.ReportOnlyPolicyIds, *policy.GetId())
+			}
+		}
+		coverage.Covered = len(coverage.EnforcingPolicyIds) > 0
+
+		d.StreamListItem(ctx, coverage)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// conditionalAccessPolicyAppliesToAllApps reports whether the policy applies to all the cloud apps, without excluding any of them
+func conditionalAccessPolicyAppliesToAllApps(policy *ADConditionalAccessPolicyInfo) bool {
+	if policy.GetConditions() == nil || policy.GetConditions().GetApplications() == nil || policy.GetConditions().GetUsers() == nil {
+		return false
+	}
+	applications := policy.GetConditions().GetApplications()
+	return helpers.StringSliceContains(applications.GetIncludeApplications(), "All") && len(applications.GetExcludeApplications()) == 0
+}
@@ -0,0 +1,144 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdConditionalAccessExcludedPrincipal(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_conditional_access_excluded_principal",
+		Description: "Represents a user, group or directory role excluded from a conditional access policy.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdConditionalAccessExcludedPrincipals,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "policy_id", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "policy_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the conditional access policy.", Transform: transform.FromField("PolicyId")},
+			{Name: "policy_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the conditional access policy.", Transform: transform.FromField("PolicyDisplayName")},
+			{Name: "exclusion_type", Type: proto.ColumnType_STRING, Description: "The type of the excluded principal. Possible values are: user, group, role.", Transform: transform.FromField("ExclusionType")},
+			{Name: "principal_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the excluded user or group, or the role template ID of the excluded directory role. Can also be GuestsOrExternalUsers for the user exclusions.", Transform: transform.FromField("PrincipalId")},
+			{Name: "principal_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the excluded principal. Null when the principal has been deleted.", Hydrate: getAdConditionalAccessExcludedPrincipalName, Transform: transform.FromField("DisplayName")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromField("PrincipalId")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdConditionalAccessExcludedPrincipals(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_conditional_access_excluded_principal.listAdConditionalAccessExcludedPrincipals", "connection_error", err)
+		return nil, err
+	}
+
+	if d.EqualsQuals["policy_id"] != nil {
+		policyId := d.EqualsQuals["policy_id"].GetStringValue()
+		if policyId == "" {
+			return nil, nil
+		}
+
+		policy, err := client.Identity().ConditionalAccess().Policies().ByConditionalAccessPolicyId(policyId).Get(ctx, nil)
+		if err != nil {
+			errObj := getErrorObject(err)
+			plugin.Logger(ctx).Error("listAdConditionalAccessExcludedPrincipals", "get_conditional_access_policy_error", errObj)
+			return nil, errObj
+		}
+
+		streamAdConditionalAccessExcludedPrincipals(ctx, d, policy)
+		return nil, nil
+	}
+
+	// Without a policy, the exclusions of all the policies are listed
+	result, err := client.Identity().ConditionalAccess().Policies().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdConditionalAccessExcludedPrincipals", "list_conditional_access_policy_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateConditionalAccessPolicyCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.ConditionalAccessPolicyable) bool {
+		return streamAdConditionalAccessExcludedPrincipals(ctx, d, pageItem)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdConditionalAccessExcludedPrincipals", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// streamAdConditionalAccessExcludedPrincipals streams a row per principal excluded from the policy, and returns false once the limit has been hit
+func streamAdConditionalAccessExcludedPrincipals(ctx context.Context, d *plugin.QueryData, policy models.ConditionalAccessPolicyable) bool {
+	if policy.GetConditions() == nil || policy.GetConditions().GetUsers() == nil {
+		return true
+	}
+	users := policy.GetConditions().GetUsers()
+
+	exclusions := map[string][]string{
+		"user":  users.GetExcludeUsers(),
+		"group": users.GetExcludeGroups(),
+		"role":  users.GetExcludeRoles(),
+	}
+
+	// All the exclusions of the policy are kept on each row, so their names can be resolved in a single batch
+	policyExclusions := []string{}
+	for _, exclusionType := range []string{"user", "group", "role"} {
+		policyExclusions = append(policyExclusions, exclusions[exclusionType]...)
+	}
+
+	for _, exclusionType := range []string{"user", "group", "role"} {
+		for _, principalId := range exclusions[exclusionType] {
+			d.StreamListItem(ctx, &ADConditionalAccessExcludedPrincipalInfo{policy.GetId(), policy.GetDisplayName(), exclusionType, principalId, policyExclusions})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAdConditionalAccessExcludedPrincipalName(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	exclusion := h.Item.(*ADConditionalAccessExcludedPrincipalInfo)
+
+	// The special values, e.g. GuestsOrExternalUsers, are not directory objects
+	objectIds := []string{}
+	for _, principalId := range exclusion.PolicyExclusions {
+		if principalId != "All" && principalId != "None" && principalId != "GuestsOrExternalUsers" {
+			objectIds = append(objectIds, principalId)
+		}
+	}
+
+	// The names of all the exclusions of the policy are resolved at once and cached, so the next rows don't make any call
+	names, err := resolveDirectoryObjectNames(ctx, d, objectIds)
+	if err != nil {
+		return nil, err
+	}
+
+	if name, ok := names[exclusion.PrincipalId]; ok {
+		return name, nil
+	}
+	return nil, nil
+}
@@ -11,7 +11,6 @@ import (
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 
-	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
 	"github.com/microsoftgraph/msgraph-sdk-go/identity"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 )
@@ -97,13 +96,7 @@ func listAdConditionalAccessNamedLocations(ctx context.Context, d *plugin.QueryD
 		return nil, errObj
 	}
 
-	pageIterator, err := msgraphcore.NewPageIterator[models.NamedLocationable](result, adapter, models.CreateNamedLocationCollectionResponseFromDiscriminatorValue)
-	if err != nil {
-		plugin.Logger(ctx).Error("azuread_conditional_access_named_location.listAdConditionalAccessNamedLocations", "create_iterator_instance_error", err)
-		return nil, err
-	}
-
-	err = pageIterator.Iterate(ctx, func(pageItem models.NamedLocationable) bool {
+	err = iteratePages(ctx, d, adapter, result, models.CreateNamedLocationCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.NamedLocationable) bool {
 		d.StreamListItem(ctx, ADNamedLocationInfo{
 			NamedLocationable: pageItem,
 			NamedLocation:     getNamedLocationDetails(pageItem),
@@ -11,7 +11,6 @@ import (
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 
-	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
 	"github.com/microsoftgraph/msgraph-sdk-go/identity"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 )
@@ -37,15 +36,25 @@ func tableAzureAdConditionalAccessPolicy(_ context.Context) *plugin.Table {
 			KeyColumns: []*plugin.KeyColumn{
 				{Name: "display_name", Require: plugin.Optional},
 				{Name: "state", Require: plugin.Optional},
+				{Name: "created_date_time", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
+				{Name: "modified_date_time", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
 			},
 		},
 
 		Columns: commonColumns([]*plugin.Column{
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "Specifies the identifier of a conditionalAccessPolicy object.", Transform: transform.FromMethod("GetId")},
 			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "Specifies a display name for the conditionalAccessPolicy object.", Transform: transform.FromMethod("GetDisplayName")},
-			{Name: "state", Type: proto.ColumnType_STRING, Description: "Specifies the state of the conditionalAccessPolicy object. Possible values are: enabled, disabled, enabledForReportingButNotEnforced.", Transform: transform.FromMethod("GetState")},
+			{Name: "state", Type: proto.ColumnType_STRING, Description: "Specifies the state of the conditionalAccessPolicy object. Possible values are: enabled, disabled, enabledForReportingButNotEnforced.", Transform: transform.FromMethod("ConditionalAccessPolicyState")},
 			{Name: "created_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The create date of the conditional access policy.", Transform: transform.FromMethod("GetCreatedDateTime")},
 			{Name: "modified_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The modification date of the conditional access policy.", Transform: transform.FromMethod("GetModifiedDateTime")},
+			{Name: "applies_to_all_users", Type: proto.ColumnType_BOOL, Description: "True if the users included in the policy contain All.", Transform: transform.FromMethod("ConditionalAccessPolicyAppliesToAllUsers")},
+			{Name: "excludes_privileged_roles", Type: proto.ColumnType_BOOL, Description: "True if the roles excluded from the policy contain any highly privileged built-in directory role, such as Global Administrator or Privileged Role Administrator.", Transform: transform.FromMethod("ConditionalAccessPolicyExcludesPrivilegedRoles")},
+			{Name: "requires_mfa", Type: proto.ColumnType_BOOL, Description: "True if the policy requires MFA, i.e. its built-in grant controls contain mfa or it requires an authentication strength. False when these are combined with other grant controls with OR, since any other control satisfies the policy.", Transform: transform.FromMethod("ConditionalAccessPolicyRequiresMfa")},
+			{Name: "requires_compliant_device", Type: proto.ColumnType_BOOL, Description: "True if the policy requires a device marked as compliant. False when compliantDevice is one of several grant controls combined with OR, since any other control satisfies the policy.", Transform: transform.FromMethod("ConditionalAccessPolicyRequiresCompliantDevice")},
+			{Name: "requires_hybrid_azure_ad_joined_device", Type: proto.ColumnType_BOOL, Description: "True if the policy requires a hybrid Azure AD joined device. False when domainJoinedDevice is one of several grant controls combined with OR, since any other control satisfies the policy.", Transform: transform.FromMethod("ConditionalAccessPolicyRequiresHybridAzureAdJoinedDevice")},
+			{Name: "requires_approved_application", Type: proto.ColumnType_BOOL, Description: "True if the policy requires an approved client app. False when approvedApplication is one of several grant controls combined with OR, since any other control satisfies the policy.", Transform: transform.FromMethod("ConditionalAccessPolicyRequiresApprovedApplication")},
+			{Name: "application_enforced_restrictions_enabled", Type: proto.ColumnType_BOOL, Description: "True if the policy enforces the restrictions of the application, e.g. the limited web access of Exchange Online and SharePoint Online on unmanaged devices.", Transform: transform.FromMethod("ConditionalAccessPolicySessionControlsApplicationEnforcedRestrictionsEnabled")},
+			{Name: "cloud_app_security_type", Type: proto.ColumnType_STRING, Description: "The Conditional Access App Control applied by the policy, when enabled. Possible values are: mcasConfigured, monitorOnly, blockDownloads, unknownFutureValue.", Transform: transform.FromMethod("ConditionalAccessPolicySessionControlsCloudAppSecurityType")},
 			{Name: "operator", Type: proto.ColumnType_STRING, Description: "Defines the relationship of the grant controls. Possible values: AND, OR.", Transform: transform.FromMethod("ConditionalAccessPolicyGrantControlsOperator")},
 
 			// Json fields
@@ -57,13 +66,14 @@ func tableAzureAdConditionalAccessPolicy(_ context.Context) *plugin.Table {
 			{Name: "custom_authentication_factors", Type: proto.ColumnType_JSON, Description: "List of custom controls IDs required by the policy.", Transform: transform.FromMethod("ConditionalAccessPolicyGrantControlsCustomAuthenticationFactors")},
 			{Name: "cloud_app_security", Type: proto.ColumnType_JSON, Description: "Session control to apply cloud app security.", Transform: transform.FromMethod("ConditionalAccessPolicySessionControlsCloudAppSecurity")},
 			{Name: "locations", Type: proto.ColumnType_JSON, Description: "Locations included in and excluded from the policy.", Transform: transform.FromMethod("ConditionalAccessPolicyConditionsLocations")},
-			{Name: "persistent_browser", Type: proto.ColumnType_JSON, Description: "Session control to define whether to persist cookies or not. All apps should be selected for this session control to work correctly.", Transform: transform.FromMethod("ConditionalAccessPolicySessionControlsPersistentBrowser")},
+			{Name: "persistent_browser", Type: proto.ColumnType_JSON, Description: "Session control to define whether to persist cookies or not, with its isEnabled flag and its mode (always or never). All apps should be selected for this session control to work correctly.", Transform: transform.FromMethod("ConditionalAccessPolicySessionControlsPersistentBrowser")},
 			{Name: "platforms", Type: proto.ColumnType_JSON, Description: "Platforms included in and excluded from the policy.", Transform: transform.FromMethod("ConditionalAccessPolicyConditionsPlatforms")},
-			{Name: "sign_in_frequency", Type: proto.ColumnType_JSON, Description: "Session control to enforce signin frequency.", Transform: transform.FromMethod("ConditionalAccessPolicySessionControlsSignInFrequency")},
+			{Name: "sign_in_frequency", Type: proto.ColumnType_JSON, Description: "Session control to enforce signin frequency, with its isEnabled flag, its value and type (days or hours), and its frequencyInterval (timeBased or everyTime).", Transform: transform.FromMethod("ConditionalAccessPolicySessionControlsSignInFrequency")},
 			{Name: "sign_in_risk_levels", Type: proto.ColumnType_JSON, Description: "Sign-in risk levels included in the policy. Possible values are: low, medium, high, hidden, none, unknownFutureValue.", Transform: transform.FromMethod("ConditionalAccessPolicyConditionsSignInRiskLevels")},
 			{Name: "terms_of_use", Type: proto.ColumnType_JSON, Description: "List of terms of use IDs required by the policy.", Transform: transform.FromMethod("ConditionalAccessPolicyGrantControlsTermsOfUse")},
 			{Name: "users", Type: proto.ColumnType_JSON, Description: "Users, groups, and roles included in and excluded from the policy.", Transform: transform.FromMethod("ConditionalAccessPolicyConditionsUsers")},
 			{Name: "user_risk_levels", Type: proto.ColumnType_JSON, Description: "User risk levels included in the policy. Possible values are: low, medium, high, hidden, none, unknownFutureValue.", Transform: transform.FromMethod("ConditionalAccessPolicyConditionsUserRiskLevels")},
+			rawColumn(),
 
 			// Standard columns
 			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adConditionalAccessPolicyTitle)},
@@ -86,8 +96,17 @@ func listAdConditionalAccessPolicies(ctx context.Context, d *plugin.QueryData, _
 		Top: Int32(1000),
 	}
 
+	// The API doesn't support filtering the policies by state or by date, so these quals are applied to the results.
+	// In that case the limit can't be pushed down as the page size, as some of the policies may be filtered out.
+	state := ""
+	if d.EqualsQuals["state"] != nil {
+		state = d.EqualsQuals["state"].GetStringValue()
+	}
+	createdDateTimeQuals := d.Quals["created_date_time"]
+	modifiedDateTimeQuals := d.Quals["modified_date_time"]
+
 	limit := d.QueryContext.Limit
-	if limit != nil {
+	if limit != nil && state == "" && createdDateTimeQuals == nil && modifiedDateTimeQuals == nil {
 		if *limit > 0 && *limit < 1000 {
 			l := int32(*limit)
 			input.Top = Int32(l)
@@ -113,14 +132,16 @@ func listAdConditionalAccessPolicies(ctx context.Context, d *plugin.QueryData, _
 		return nil, errObj
 	}
 
-	pageIterator, err := msgraphcore.NewPageIterator[models.ConditionalAccessPolicyable](result, adapter, models.CreateConditionalAccessPolicyCollectionResponseFromDiscriminatorValue)
-	if err != nil {
-		plugin.Logger(ctx).Error("listAdConditionalAccessPolicies", "create_iterator_instance_error", err)
-		return nil, err
-	}
+	err = iteratePages(ctx, d, adapter, result, models.CreateConditionalAccessPolicyCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.ConditionalAccessPolicyable) bool {
+		policy := &ADConditionalAccessPolicyInfo{pageItem}
+		if state != "" && policy.ConditionalAccessPolicyState() != state {
+			return true
+		}
+		if !matchesTimestampQuals(policy.GetCreatedDateTime(), createdDateTimeQuals) || !matchesTimestampQuals(policy.GetModifiedDateTime(), modifiedDateTimeQuals) {
+			return true
+		}
 
-	err = pageIterator.Iterate(ctx, func(pageItem models.ConditionalAccessPolicyable) bool {
-		d.StreamListItem(ctx, &ADConditionalAccessPolicyInfo{pageItem})
+		d.StreamListItem(ctx, policy)
 
 		// Context can be cancelled due to manual cancellation or the limit has been hit
 		return d.RowsRemaining(ctx) != 0
@@ -163,7 +184,6 @@ func buildConditionalAccessPolicyQueryFilter(equalQuals plugin.KeyColumnEqualsQu
 
 	filterQuals := map[string]string{
 		"display_name": "string",
-		"state":        "string",
 	}
 
 	for qual, qualType := range filterQuals {
@@ -0,0 +1,104 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdCrossTenantPartner(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_cross_tenant_partner",
+		Description: "Represents the cross-tenant access settings specific to a partner tenant, which override the default settings for the B2B collaboration and the B2B direct connect with that tenant.",
+		Get: &plugin.GetConfig{
+			Hydrate: getAdCrossTenantPartner,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "ResourceNotFound"}),
+			},
+			KeyColumns: plugin.SingleColumn("partner_tenant_id"),
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAdCrossTenantPartners,
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "partner_tenant_id", Type: proto.ColumnType_STRING, Description: "The tenant ID of the partner organization.", Transform: transform.FromMethod("GetTenantId")},
+			{Name: "is_service_provider", Type: proto.ColumnType_BOOL, Description: "True if the partner is a Cloud Service Provider (CSP) for the tenant.", Transform: transform.FromMethod("GetIsServiceProvider")},
+			{Name: "is_in_multi_tenant_organization", Type: proto.ColumnType_BOOL, Description: "True if the partner tenant is a member of the same multitenant organization as the tenant.", Transform: transform.FromMethod("GetIsInMultiTenantOrganization")},
+
+			// JSON fields
+			{Name: "automatic_user_consent_settings", Type: proto.ColumnType_JSON, Description: "Whether the consent prompts are suppressed for the users of the tenant accessing the partner tenant (outbound) and for the users of the partner tenant accessing the tenant (inbound).", Transform: transform.FromMethod("CrossTenantPartnerAutomaticUserConsentSettings")},
+			{Name: "b2b_collaboration_inbound", Type: proto.ColumnType_JSON, Description: "The users, groups and applications of the partner tenant allowed or blocked to collaborate with the tenant as guests. Null if the default settings apply.", Transform: transform.FromMethod("CrossTenantPartnerB2bCollaborationInbound")},
+			{Name: "b2b_collaboration_outbound", Type: proto.ColumnType_JSON, Description: "The users and groups of the tenant allowed or blocked to collaborate with the partner tenant as guests, and the external applications they can access. Null if the default settings apply.", Transform: transform.FromMethod("CrossTenantPartnerB2bCollaborationOutbound")},
+			{Name: "b2b_direct_connect_inbound", Type: proto.ColumnType_JSON, Description: "The users, groups and applications of the partner tenant allowed or blocked to access the tenant through B2B direct connect. Null if the default settings apply.", Transform: transform.FromMethod("CrossTenantPartnerB2bDirectConnectInbound")},
+			{Name: "b2b_direct_connect_outbound", Type: proto.ColumnType_JSON, Description: "The users and groups of the tenant allowed or blocked to access the partner tenant through B2B direct connect. Null if the default settings apply.", Transform: transform.FromMethod("CrossTenantPartnerB2bDirectConnectOutbound")},
+			{Name: "inbound_trust", Type: proto.ColumnType_JSON, Description: "Whether the MFA, the compliant device and the hybrid Azure AD joined device claims issued by the partner tenant are trusted by the Conditional Access policies of the tenant. Null if the default settings apply.", Transform: transform.FromMethod("CrossTenantPartnerInboundTrust")},
+			{Name: "tenant_restrictions", Type: proto.ColumnType_JSON, Description: "The users, groups and applications of the partner tenant which the users of the tenant can access on the networks and devices of the tenant. Null if the default settings apply.", Transform: transform.FromMethod("CrossTenantPartnerTenantRestrictions")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetTenantId")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdCrossTenantPartners(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_cross_tenant_partner.listAdCrossTenantPartners", "connection_error", err)
+		return nil, err
+	}
+
+	result, err := client.Policies().CrossTenantAccessPolicy().Partners().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdCrossTenantPartners", "list_cross_tenant_partner_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateCrossTenantAccessPolicyConfigurationPartnerCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.CrossTenantAccessPolicyConfigurationPartnerable) bool {
+		d.StreamListItem(ctx, &ADCrossTenantPartnerInfo{pageItem})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdCrossTenantPartners", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAdCrossTenantPartner(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	partnerTenantId := d.EqualsQuals["partner_tenant_id"].GetStringValue()
+	if partnerTenantId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_cross_tenant_partner.getAdCrossTenantPartner", "connection_error", err)
+		return nil, err
+	}
+
+	partner, err := client.Policies().CrossTenantAccessPolicy().Partners().ByCrossTenantAccessPolicyConfigurationPartnerTenantId(partnerTenantId).Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getAdCrossTenantPartner", "get_cross_tenant_partner_error", errObj)
+		return nil, errObj
+	}
+
+	return &ADCrossTenantPartnerInfo{partner}, nil
+}
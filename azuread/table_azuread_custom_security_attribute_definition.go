@@ -0,0 +1,134 @@
+package azuread
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/directory"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdCustomSecurityAttributeDefinition(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_custom_security_attribute_definition",
+		Description: "Represents the definition of a custom security attribute, which is assigned to the users and the service principals, e.g. for attribute-based access control (ABAC).",
+		List: &plugin.ListConfig{
+			Hydrate: listAdCustomSecurityAttributeDefinitions,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "attribute_set", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "attribute_set", Type: proto.ColumnType_STRING, Description: "The name of the attribute set the attribute belongs to.", Transform: transform.FromMethod("GetAttributeSet")},
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the attribute, made of the name of the attribute set and the name of the attribute, e.g. Engineering_ProjectDate.", Transform: transform.FromMethod("GetId")},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the attribute, unique in its attribute set.", Transform: transform.FromMethod("GetName")},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The data type of the values of the attribute. Possible values are: Boolean, Integer, String.", Transform: transform.FromMethod("GetTypeEscaped")},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the attribute. Possible values are: Available, Deprecated.", Transform: transform.FromMethod("GetStatus")},
+			{Name: "is_collection", Type: proto.ColumnType_BOOL, Description: "True if multiple values can be assigned to the attribute.", Transform: transform.FromMethod("GetIsCollection")},
+			{Name: "is_searchable", Type: proto.ColumnType_BOOL, Description: "True if the values of the attribute are indexed for searching on the objects they are assigned to.", Transform: transform.FromMethod("GetIsSearchable")},
+			{Name: "use_pre_defined_values_only", Type: proto.ColumnType_BOOL, Description: "True if only the predefined values can be assigned to the attribute.", Transform: transform.FromMethod("GetUsePreDefinedValuesOnly")},
+
+			// Other fields
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the attribute.", Transform: transform.FromMethod("GetDescription")},
+			{Name: "attribute_set_description", Type: proto.ColumnType_STRING, Description: "The description of the attribute set.", Transform: transform.FromMethod("CustomSecurityAttributeDefinitionAttributeSetDescription")},
+			{Name: "attribute_set_max_attributes", Type: proto.ColumnType_INT, Description: "The maximum number of attributes of the attribute set.", Transform: transform.FromMethod("CustomSecurityAttributeDefinitionAttributeSetMaxAttributes")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetId")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdCustomSecurityAttributeDefinitions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_custom_security_attribute_definition.listAdCustomSecurityAttributeDefinitions", "connection_error", err)
+		return nil, err
+	}
+
+	input := &directory.CustomSecurityAttributeDefinitionsRequestBuilderGetQueryParameters{}
+	if d.EqualsQuals["attribute_set"] != nil {
+		filter := fmt.Sprintf("attributeSet eq '%s'", escapeODataString(d.EqualsQuals["attribute_set"].GetStringValue()))
+		input.Filter = &filter
+	}
+
+	options := &directory.CustomSecurityAttributeDefinitionsRequestBuilderGetRequestConfiguration{
+		QueryParameters: input,
+	}
+
+	// The attribute sets are read once per connection, to describe the set of each attribute
+	attributeSets, err := getAttributeSetsMemoized(ctx, d, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.Directory().CustomSecurityAttributeDefinitions().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdCustomSecurityAttributeDefinitions", "list_custom_security_attribute_definition_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateCustomSecurityAttributeDefinitionCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.CustomSecurityAttributeDefinitionable) bool {
+		definition := &ADCustomSecurityAttributeDefinitionInfo{CustomSecurityAttributeDefinitionable: pageItem}
+		for _, attributeSet := range attributeSets.([]models.AttributeSetable) {
+			if attributeSet.GetId() != nil && pageItem.GetAttributeSet() != nil && *attributeSet.GetId() == *pageItem.GetAttributeSet() {
+				definition.AttributeSet = attributeSet
+			}
+		}
+
+		d.StreamListItem(ctx, definition)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdCustomSecurityAttributeDefinitions", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// The attribute sets are few and shared by all the attributes, so they are fetched once per connection
+var getAttributeSetsMemoized = plugin.HydrateFunc(getAttributeSetsUncached).Memoize()
+
+func getAttributeSetsUncached(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_custom_security_attribute_definition.getAttributeSetsUncached", "connection_error", err)
+		return nil, err
+	}
+
+	result, err := client.Directory().AttributeSets().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getAttributeSetsUncached", "list_attribute_set_error", errObj)
+		return nil, errObj
+	}
+
+	attributeSets := []models.AttributeSetable{}
+	err = iteratePages(ctx, d, adapter, result, models.CreateAttributeSetCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.AttributeSetable) bool {
+		attributeSets = append(attributeSets, pageItem)
+		return true
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("getAttributeSetsUncached", "paging_error", err)
+		return nil, err
+	}
+
+	return attributeSets, nil
+}
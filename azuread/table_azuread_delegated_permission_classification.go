@@ -0,0 +1,93 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdDelegatedPermissionClassification(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_delegated_permission_classification",
+		Description: "Represents the classification of a delegated permission exposed by a service principal, which governs the permissions users can consent to on their own.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdDelegatedPermissionClassifications,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "sp_id", Require: plugin.Required},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "sp_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the service principal which exposes the delegated permission.", Transform: transform.FromField("ServicePrincipalId")},
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier for the delegated permission classification.", Transform: transform.FromMethod("GetId")},
+			{Name: "permission_id", Type: proto.ColumnType_STRING, Description: "The unique identifier (id) for the delegated permission listed in the oauth2PermissionScopes collection of the service principal.", Transform: transform.FromMethod("GetPermissionId")},
+			{Name: "permission_name", Type: proto.ColumnType_STRING, Description: "The claim value (value) for the delegated permission listed in the oauth2PermissionScopes collection of the service principal.", Transform: transform.FromMethod("GetPermissionName")},
+			{Name: "classification", Type: proto.ColumnType_STRING, Description: "The classification value being given. Possible value: low, medium, high.", Transform: transform.FromMethod("DelegatedPermissionClassificationClassification")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adDelegatedPermissionClassificationTitle)},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdDelegatedPermissionClassifications(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	servicePrincipalId := d.EqualsQuals["sp_id"].GetStringValue()
+	if servicePrincipalId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_delegated_permission_classification.listAdDelegatedPermissionClassifications", "connection_error", err)
+		return nil, err
+	}
+
+	// The API doesn't support the $top query parameter, so the page size is left to the service
+	result, err := client.ServicePrincipals().ByServicePrincipalId(servicePrincipalId).DelegatedPermissionClassifications().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdDelegatedPermissionClassifications", "list_delegated_permission_classification_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateDelegatedPermissionClassificationCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.DelegatedPermissionClassificationable) bool {
+		d.StreamListItem(ctx, &ADDelegatedPermissionClassificationInfo{pageItem, &servicePrincipalId})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdDelegatedPermissionClassifications", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func adDelegatedPermissionClassificationTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADDelegatedPermissionClassificationInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	title := data.GetPermissionName()
+	if title == nil {
+		title = data.GetId()
+	}
+
+	return title, nil
+}
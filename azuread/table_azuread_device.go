@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/iancoleman/strcase"
-	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
 	"github.com/microsoftgraph/msgraph-sdk-go/devices"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 	"github.com/turbot/go-kit/helpers"
@@ -60,6 +59,7 @@ func tableAzureAdDevice(_ context.Context) *plugin.Table {
 			// JSON fields
 			{Name: "extension_attributes", Type: proto.ColumnType_JSON, Description: "Contains extension attributes 1-15 for the device. The individual extension attributes are not selectable. These properties are mastered in cloud and can be set during creation or update of a device object in Azure AD.", Transform: transform.FromMethod("GetExtensions")},
 			{Name: "member_of", Type: proto.ColumnType_JSON, Description: "A list the groups and directory roles that the device is a direct member of.", Transform: transform.FromMethod("DeviceMemberOf")},
+			rawColumn(),
 
 			// Standard columns
 			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adDeviceTitle)},
@@ -99,6 +99,11 @@ func listAdDevices(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 	givenColumns := d.QueryContext.Columns
 	selectColumns, expandColumns := buildDeviceRequestFields(ctx, givenColumns)
 
+	// The raw column holds the full device, which Graph returns when no property is selected
+	if isRawRequested(d) {
+		selectColumns = nil
+	}
+
 	input.Select = selectColumns
 	input.Expand = expandColumns
 
@@ -129,13 +134,7 @@ func listAdDevices(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 		return nil, errObj
 	}
 
-	pageIterator, err := msgraphcore.NewPageIterator[models.Deviceable](result, adapter, models.CreateDeviceCollectionResponseFromDiscriminatorValue)
-	if err != nil {
-		plugin.Logger(ctx).Error("azuread_device.listAdDevices", "create_iterator_instance_error", err)
-		return nil, err
-	}
-
-	err = pageIterator.Iterate(ctx, func(pageItem models.Deviceable) bool {
+	err = iteratePages(ctx, d, adapter, result, models.CreateDeviceCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.Deviceable) bool {
 		d.StreamListItem(ctx, &ADDeviceInfo{pageItem})
 
 		// Context can be cancelled due to manual cancellation or the limit has been hit
@@ -170,6 +169,11 @@ func getAdDevice(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 	givenColumns := d.QueryContext.Columns
 	selectColumns, expandColumns := buildDeviceRequestFields(ctx, givenColumns)
 
+	// The raw column holds the full device, which Graph returns when no property is selected
+	if isRawRequested(d) {
+		selectColumns = nil
+	}
+
 	input := &devices.DeviceItemRequestBuilderGetQueryParameters{}
 	input.Select = selectColumns
 	input.Expand = expandColumns
@@ -208,7 +212,7 @@ func buildDeviceRequestFields(ctx context.Context, queryColumns []string) ([]str
 	var selectColumns, expandColumns []string
 
 	for _, columnName := range queryColumns {
-		if columnName == "filter" || columnName == "tenant_id" {
+		if columnName == "filter" || columnName == "tenant_id" || columnName == "raw" {
 			continue
 		}
 
@@ -250,7 +254,7 @@ func buildDeviceQueryFilter(equalQuals plugin.KeyColumnEqualsQualMap) []string {
 		switch qualType {
 		case "string":
 			if equalQuals[qual] != nil {
-				filters = append(filters, fmt.Sprintf("%s eq '%s'", strcase.ToCamel(qual), equalQuals[qual].GetStringValue()))
+				filters = append(filters, fmt.Sprintf("%s eq '%s'", strcase.ToCamel(qual), escapeODataString(equalQuals[qual].GetStringValue())))
 			}
 		case "bool":
 			if equalQuals[qual] != nil {
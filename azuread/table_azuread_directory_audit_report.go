@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/iancoleman/strcase"
-	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
 	"github.com/microsoftgraph/msgraph-sdk-go/auditlogs"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 
@@ -38,6 +37,9 @@ func tableAzureAdDirectoryAuditReport(_ context.Context) *plugin.Table {
 				{Name: "category", Require: plugin.Optional},
 				{Name: "correlation_id", Require: plugin.Optional},
 				{Name: "filter", Require: plugin.Optional},
+				{Name: "initiated_by_app_id", Require: plugin.Optional},
+				{Name: "initiated_by_user_id", Require: plugin.Optional},
+				{Name: "initiated_by_user_principal_name", Require: plugin.Optional},
 				{Name: "result", Require: plugin.Optional},
 			},
 		},
@@ -51,12 +53,20 @@ func tableAzureAdDirectoryAuditReport(_ context.Context) *plugin.Table {
 			{Name: "logged_by_service", Type: proto.ColumnType_STRING, Description: "Indicates information on which service initiated the activity (For example: Self-service Password Management, Core Directory, B2C, Invited Users, Microsoft Identity Manager, Privileged Identity Management.", Transform: transform.FromMethod("GetLoggedByService")},
 			{Name: "operation_type", Type: proto.ColumnType_STRING, Description: "Indicates the type of operation that was performed. The possible values include but are not limited to the following: Add, Assign, Update, Unassign, and Delete.", Transform: transform.FromMethod("GetOperationType")},
 			{Name: "result", Type: proto.ColumnType_STRING, Description: "Indicates the result of the activity. Possible values are: success, failure, timeout, unknownFutureValue.", Transform: transform.FromMethod("DirectoryAuditResult")},
+			{Name: "initiated_by_user_id", Type: proto.ColumnType_STRING, Description: "The ID of the user who initiated the activity. Null when the activity was initiated by an app.", Transform: transform.FromMethod("DirectoryAuditInitiatedByUserId")},
+			{Name: "initiated_by_user_principal_name", Type: proto.ColumnType_STRING, Description: "The user principal name of the user who initiated the activity. Null when the activity was initiated by an app.", Transform: transform.FromMethod("DirectoryAuditInitiatedByUserPrincipalName")},
+			{Name: "initiated_by_app_id", Type: proto.ColumnType_STRING, Description: "The application ID of the app which initiated the activity. Null when the activity was initiated by a user.", Transform: transform.FromMethod("DirectoryAuditInitiatedByAppId")},
+			{Name: "initiated_by_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the user or the app which initiated the activity.", Transform: transform.FromMethod("DirectoryAuditInitiatedByDisplayName")},
+			{Name: "target_id", Type: proto.ColumnType_STRING, Description: "The ID of the first resource changed by the activity. See target_resources for all the changed resources.", Transform: transform.FromMethod("DirectoryAuditTargetId")},
+			{Name: "target_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the first resource changed by the activity.", Transform: transform.FromMethod("DirectoryAuditTargetDisplayName")},
+			{Name: "target_type", Type: proto.ColumnType_STRING, Description: "The type of the first resource changed by the activity, e.g. User, Device, Directory, App, Role, Group, Policy or Other.", Transform: transform.FromMethod("DirectoryAuditTargetType")},
 			{Name: "result_reason", Type: proto.ColumnType_STRING, Description: "Indicates the reason for failure if the result is failure or timeout.", Transform: transform.FromMethod("GetResultReason")},
 
 			// JSON fields
 			{Name: "additional_details", Type: proto.ColumnType_JSON, Description: "Indicates additional details on the activity.", Transform: transform.FromMethod("DirectoryAuditAdditionalDetails")},
 			{Name: "initiated_by", Type: proto.ColumnType_JSON, Description: "Indicates information about the user or app initiated the activity.", Transform: transform.FromMethod("DirectoryAuditInitiatedBy")},
 			{Name: "target_resources", Type: proto.ColumnType_JSON, Description: "Indicates information on which resource was changed due to the activity. Target Resource Type can be User, Device, Directory, App, Role, Group, Policy or Other.", Transform: transform.FromMethod("DirectoryAuditTargetResources")},
+			rawColumn(),
 
 			// Standard columns
 			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetId")},
@@ -140,13 +150,7 @@ func listAdDirectoryAuditReports(ctx context.Context, d *plugin.QueryData, _ *pl
 		return nil, errObj
 	}
 
-	pageIterator, err := msgraphcore.NewPageIterator[interface{}](result, adapter, models.CreateSignInCollectionResponseFromDiscriminatorValue)
-	if err != nil {
-		plugin.Logger(ctx).Error("listAdDirectoryAuditReports", "create_iterator_instance_error", err)
-		return nil, err
-	}
-
-	err = pageIterator.Iterate(ctx, func(pageItem interface{}) bool {
+	err = iteratePages(ctx, d, adapter, result, models.CreateDirectoryAuditCollectionResponseFromDiscriminatorValue, nil, func(pageItem interface{}) bool {
 		// To prevent errors during type conversion caused by inconsistent API responses (especially with larger data sets), we may get the different type of response (models.SignInable), we need to include the following check.
 		if directoryAudit, ok := pageItem.(models.DirectoryAuditable); ok {
 			d.StreamListItem(ctx, &ADDirectoryAuditReportInfo{directoryAudit})
@@ -201,7 +205,20 @@ func buildDirectoryAuditQueryFilter(equalQuals plugin.KeyColumnEqualsQualMap) []
 
 	for qual := range filterQuals {
 		if equalQuals[qual] != nil {
-			filters = append(filters, fmt.Sprintf("%s eq '%s'", strcase.ToCamel(qual), equalQuals[qual].GetStringValue()))
+			filters = append(filters, fmt.Sprintf("%s eq '%s'", strcase.ToCamel(qual), escapeODataString(equalQuals[qual].GetStringValue())))
+		}
+	}
+
+	// The initiator columns are flattened from initiatedBy, so they are filtered on the path of the nested property
+	initiatedByQuals := map[string]string{
+		"initiated_by_app_id":              "initiatedBy/app/appId",
+		"initiated_by_user_id":             "initiatedBy/user/id",
+		"initiated_by_user_principal_name": "initiatedBy/user/userPrincipalName",
+	}
+
+	for qual, property := range initiatedByQuals {
+		if equalQuals[qual] != nil {
+			filters = append(filters, fmt.Sprintf("%s eq '%s'", property, escapeODataString(equalQuals[qual].GetStringValue())))
 		}
 	}
 
@@ -0,0 +1,83 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdDirectoryObject(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_directory_object",
+		Description: "Represents any Azure AD directory object, such as a user, group, service principal or device, resolved by its ID.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdDirectoryObjects,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "id", Require: plugin.Required},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier for the directory object.", Transform: transform.FromMethod("GetId")},
+			{Name: "object_type", Type: proto.ColumnType_STRING, Description: "The type of the directory object, for example user, group, servicePrincipal or device.", Transform: transform.FromMethod("DirectoryObjectType")},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name of the directory object, if the object type has one.", Transform: transform.FromMethod("DirectoryObjectDisplayName")},
+			{Name: "deleted_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time when this object was deleted. Always null when the object hasn't been deleted.", Transform: transform.FromMethod("GetDeletedDateTime")},
+
+			// JSON fields
+			{Name: "raw", Type: proto.ColumnType_JSON, Description: "The full directory object as returned by Microsoft Graph.", Transform: transform.FromMethod("DirectoryObjectRaw")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adDirectoryObjectTitle)},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdDirectoryObjects(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	objectId := d.EqualsQuals["id"].GetStringValue()
+	if objectId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_directory_object.listAdDirectoryObjects", "connection_error", err)
+		return nil, err
+	}
+
+	directoryObject, err := client.DirectoryObjects().ByDirectoryObjectId(objectId).Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdDirectoryObjects", "get_directory_object_error", errObj)
+		return nil, errObj
+	}
+
+	d.StreamListItem(ctx, &ADDirectoryObjectInfo{directoryObject})
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func adDirectoryObjectTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADDirectoryObjectInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	title := data.DirectoryObjectDisplayName()
+	if title == nil {
+		title = data.GetId()
+	}
+
+	return title, nil
+}
@@ -0,0 +1,128 @@
+package azuread
+
+import (
+	"context"
+	"strings"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdDirectoryObjectMemberOf(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_directory_object_member_of",
+		Description: "Represents a group, directory role or administrative unit a directory object is a member of, whether the object is a user, a group, a service principal, a device or an organizational contact.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdDirectoryObjectMemberOf,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "object_id", Require: plugin.Required},
+				{Name: "object_type", Require: plugin.Optional},
+				{Name: "transitive", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "object_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the directory object.", Transform: transform.FromField("ObjectId")},
+			{Name: "object_type", Type: proto.ColumnType_STRING, Description: "The type of the directory object. Possible values are: user, group, servicePrincipal, device, orgContact.", Transform: transform.FromField("ObjectType")},
+			{Name: "container_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the group, directory role or administrative unit the object is a member of.", Transform: transform.FromMethod("GetId")},
+			{Name: "container_type", Type: proto.ColumnType_STRING, Description: "The type of the container. Possible values are: group, directoryRole, administrativeUnit.", Transform: transform.FromMethod("DirectoryObjectType")},
+			{Name: "container_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the container.", Transform: transform.FromMethod("DirectoryObjectDisplayName")},
+			{Name: "transitive", Type: proto.ColumnType_BOOL, Description: "True if the memberships are read transitively, i.e. including the containers the object is a member of through a nested group. Defaults to false, which only returns the direct memberships.", Transform: transform.FromField("Transitive")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("DirectoryObjectDisplayName")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdDirectoryObjectMemberOf(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	objectId := d.EqualsQuals["object_id"].GetStringValue()
+	if objectId == "" {
+		return nil, nil
+	}
+	transitive := d.EqualsQuals["transitive"].GetBoolValue()
+
+	// The memberships are read from the endpoint of the object type, which is resolved when not given
+	objectType := ""
+	if d.EqualsQuals["object_type"] != nil {
+		objectType = d.EqualsQuals["object_type"].GetStringValue()
+	} else {
+		object, err := resolveDirectoryObjectName(ctx, d, objectId)
+		if err != nil {
+			return nil, err
+		}
+		objectType = object.ObjectType
+	}
+
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_directory_object_member_of.listAdDirectoryObjectMemberOf", "connection_error", err)
+		return nil, err
+	}
+
+	var result models.DirectoryObjectCollectionResponseable
+	switch strings.ToLower(objectType) {
+	case "user":
+		if transitive {
+			result, err = client.Users().ByUserId(objectId).TransitiveMemberOf().Get(ctx, nil)
+		} else {
+			result, err = client.Users().ByUserId(objectId).MemberOf().Get(ctx, nil)
+		}
+	case "group":
+		if transitive {
+			result, err = client.Groups().ByGroupId(objectId).TransitiveMemberOf().Get(ctx, nil)
+		} else {
+			result, err = client.Groups().ByGroupId(objectId).MemberOf().Get(ctx, nil)
+		}
+	case "serviceprincipal":
+		if transitive {
+			result, err = client.ServicePrincipals().ByServicePrincipalId(objectId).TransitiveMemberOf().Get(ctx, nil)
+		} else {
+			result, err = client.ServicePrincipals().ByServicePrincipalId(objectId).MemberOf().Get(ctx, nil)
+		}
+	case "device":
+		if transitive {
+			result, err = client.Devices().ByDeviceId(objectId).TransitiveMemberOf().Get(ctx, nil)
+		} else {
+			result, err = client.Devices().ByDeviceId(objectId).MemberOf().Get(ctx, nil)
+		}
+	case "orgcontact":
+		if transitive {
+			result, err = client.Contacts().ByOrgContactId(objectId).TransitiveMemberOf().Get(ctx, nil)
+		} else {
+			result, err = client.Contacts().ByOrgContactId(objectId).MemberOf().Get(ctx, nil)
+		}
+	default:
+		// Other object types, e.g. applications, can't be members of a group
+		return nil, nil
+	}
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdDirectoryObjectMemberOf", "list_member_of_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateDirectoryObjectCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.DirectoryObjectable) bool {
+		d.StreamListItem(ctx, &ADDirectoryObjectMemberOfInfo{ADDirectoryObjectInfo{pageItem}, &objectId, objectType, transitive})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdDirectoryObjectMemberOf", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
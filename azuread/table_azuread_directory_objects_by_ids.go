@@ -0,0 +1,101 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/directoryobjects"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// The getByIds action accepts up to 1000 IDs per request
+const directoryObjectsByIdsBatchSize = 1000
+
+//// TABLE DEFINITION
+
+func tableAzureAdDirectoryObjectsByIds(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_directory_objects_by_ids",
+		Description: "Resolves a list of Azure AD directory object IDs in bulk.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdDirectoryObjectsByIds,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "id", Require: plugin.Required},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier for the directory object.", Transform: transform.FromMethod("GetId")},
+			{Name: "object_type", Type: proto.ColumnType_STRING, Description: "The type of the directory object, for example user, group, servicePrincipal or device.", Transform: transform.FromMethod("DirectoryObjectType")},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name of the directory object, if the object type has one.", Transform: transform.FromMethod("DirectoryObjectDisplayName")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adDirectoryObjectTitle)},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdDirectoryObjectsByIds(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// The IDs can be passed either as a single value or as a list, e.g. id in ('...', '...')
+	var ids []string
+	if q := d.EqualsQuals["id"]; q != nil {
+		if q.GetListValue() != nil {
+			for _, v := range q.GetListValue().Values {
+				ids = append(ids, v.GetStringValue())
+			}
+		} else if q.GetStringValue() != "" {
+			ids = append(ids, q.GetStringValue())
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_directory_objects_by_ids.listAdDirectoryObjectsByIds", "connection_error", err)
+		return nil, err
+	}
+
+	cache := getDisplayNameCache(d)
+
+	for start := 0; start < len(ids); start += directoryObjectsByIdsBatchSize {
+		end := start + directoryObjectsByIdsBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		body := directoryobjects.NewGetByIdsPostRequestBody()
+		body.SetIds(ids[start:end])
+
+		result, err := client.DirectoryObjects().GetByIds().PostAsGetByIdsPostResponse(ctx, body, nil)
+		if err != nil {
+			errObj := getErrorObject(err)
+			plugin.Logger(ctx).Error("listAdDirectoryObjectsByIds", "get_directory_objects_by_ids_error", errObj)
+			return nil, errObj
+		}
+
+		for _, directoryObject := range result.GetValue() {
+			item := &ADDirectoryObjectInfo{directoryObject}
+
+			// Keep the resolved names, so the other tables don't have to resolve these objects again
+			if item.GetId() != nil {
+				cache.Add(*item.GetId(), newDirectoryObjectName(item))
+			}
+
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 
 	abstractions "github.com/microsoft/kiota-abstractions-go"
-	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
 	"github.com/microsoftgraph/msgraph-sdk-go/directoryroles"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -36,6 +35,8 @@ func tableAzureAdDirectoryRole(_ context.Context) *plugin.Table {
 			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name for the directory role.", Transform: transform.FromMethod("GetDisplayName")},
 
 			// Other fields
+			{Name: "is_activated", Type: proto.ColumnType_BOOL, Description: "Indicates whether the role is activated in the tenant. Always true, as only the activated roles are directory roles; see azuread_directory_role_template for the roles which are not activated.", Transform: transform.FromConstant(true)},
+			{Name: "member_count", Type: proto.ColumnType_INT, Hydrate: getDirectoryRoleMembers, Transform: transform.From(adDirectoryRoleMemberCount), Description: "The number of members of the directory role."},
 			{Name: "role_template_id", Type: proto.ColumnType_STRING, Description: "The id of the directoryRoleTemplate that this role is based on. The property must be specified when activating a directory role in a tenant with a POST operation. After the directory role has been activated, the property is read only.", Transform: transform.FromMethod("GetRoleTemplateId")},
 
 			// Json fields
@@ -136,13 +137,7 @@ func getDirectoryRoleMembers(ctx context.Context, d *plugin.QueryData, h *plugin
 		return nil, errObj
 	}
 
-	pageIterator, err := msgraphcore.NewPageIterator[models.DirectoryObjectable](members, adapter, models.CreateDirectoryObjectCollectionResponseFromDiscriminatorValue)
-	if err != nil {
-		plugin.Logger(ctx).Error("getDirectoryRoleMembers", "create_iterator_instance_error", err)
-		return nil, err
-	}
-
-	err = pageIterator.Iterate(ctx, func(pageItem models.DirectoryObjectable) bool {
+	err = iteratePages(ctx, d, adapter, members, models.CreateDirectoryObjectCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.DirectoryObjectable) bool {
 		memberIds = append(memberIds, pageItem.GetId())
 
 		return true
@@ -170,3 +165,12 @@ func adDirectoryRoleTitle(_ context.Context, d *transform.TransformData) (interf
 
 	return title, nil
 }
+
+func adDirectoryRoleMemberCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	memberIds, ok := d.HydrateItem.([]*string)
+	if !ok {
+		return nil, nil
+	}
+
+	return len(memberIds), nil
+}
@@ -0,0 +1,151 @@
+package azuread
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	abstractions "github.com/microsoft/kiota-abstractions-go"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/rolemanagement"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdDirectoryRoleAssignment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_directory_role_assignment",
+		Description: "Represents the assignment of a directory role definition to a principal, at the scope of the tenant, an administrative unit or an application.",
+		Get: &plugin.GetConfig{
+			Hydrate: getAdDirectoryRoleAssignment,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAdDirectoryRoleAssignments,
+			KeyColumns: plugin.KeyColumnSlice{
+				// Key fields
+				{Name: "principal_id", Require: plugin.Optional},
+				{Name: "role_definition_id", Require: plugin.Optional},
+				{Name: "directory_scope_id", Require: plugin.Optional},
+				{Name: "app_scope_id", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the role assignment.", Transform: transform.FromMethod("GetId")},
+			{Name: "principal_id", Type: proto.ColumnType_STRING, Description: "The identifier of the user, group or service principal the role is assigned to.", Transform: transform.FromMethod("GetPrincipalId")},
+			{Name: "role_definition_id", Type: proto.ColumnType_STRING, Description: "The identifier of the role definition, which is the template ID for the built-in roles.", Transform: transform.FromMethod("GetRoleDefinitionId")},
+			{Name: "role_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the role definition.", Transform: transform.FromMethod("DirectoryRoleAssignmentRoleDisplayName")},
+			{Name: "directory_scope_id", Type: proto.ColumnType_STRING, Description: "The identifier of the directory object representing the scope of the assignment, / for the whole tenant.", Transform: transform.FromMethod("GetDirectoryScopeId")},
+
+			// Other fields
+			{Name: "scope_type", Type: proto.ColumnType_STRING, Description: "Where the role applies. Possible values are: tenant, administrativeUnit, for an administrative unit, directoryObject, for a single object such as an application, and application, for an app specific scope.", Transform: transform.FromMethod("DirectoryRoleAssignmentScopeType")},
+			{Name: "app_scope_id", Type: proto.ColumnType_STRING, Description: "The identifier of the app specific scope of the assignment, when the scope is defined by the application.", Transform: transform.FromMethod("GetAppScopeId")},
+			{Name: "condition", Type: proto.ColumnType_STRING, Description: "The condition of the assignment, used to restrict the assignment of the role.", Transform: transform.FromMethod("GetCondition")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetId")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdDirectoryRoleAssignments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_directory_role_assignment.listAdDirectoryRoleAssignments", "connection_error", err)
+		return nil, err
+	}
+
+	input := &rolemanagement.DirectoryRoleAssignmentsRequestBuilderGetQueryParameters{
+		Expand: []string{"roleDefinition($select=displayName)"},
+	}
+
+	filterQuals := map[string]string{
+		"principal_id":       "principalId",
+		"role_definition_id": "roleDefinitionId",
+		"directory_scope_id": "directoryScopeId",
+		"app_scope_id":       "appScopeId",
+	}
+
+	var filter []string
+	for qual, property := range filterQuals {
+		if d.EqualsQuals[qual] != nil {
+			filter = append(filter, fmt.Sprintf("%s eq '%s'", property, escapeODataString(d.EqualsQuals[qual].GetStringValue())))
+		}
+	}
+
+	// Some combinations of the filters are advanced queries, which require the ConsistencyLevel header
+	headers := &abstractions.RequestHeaders{}
+	if len(filter) > 0 {
+		joinStr := strings.Join(filter, " and ")
+		input.Filter = &joinStr
+		headers.Add("ConsistencyLevel", "eventual")
+	}
+
+	options := &rolemanagement.DirectoryRoleAssignmentsRequestBuilderGetRequestConfiguration{
+		QueryParameters: input,
+		Headers:         headers,
+	}
+
+	result, err := client.RoleManagement().Directory().RoleAssignments().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdDirectoryRoleAssignments", "list_role_assignment_error", errObj)
+		return nil, errObj
+	}
+
+	// The next page requests must carry the same headers as the first one
+	err = iteratePages(ctx, d, adapter, result, models.CreateUnifiedRoleAssignmentCollectionResponseFromDiscriminatorValue, headers, func(pageItem models.UnifiedRoleAssignmentable) bool {
+		d.StreamListItem(ctx, &ADDirectoryRoleAssignmentInfo{pageItem})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdDirectoryRoleAssignments", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAdDirectoryRoleAssignment(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	roleAssignmentId := d.EqualsQuals["id"].GetStringValue()
+	if roleAssignmentId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_directory_role_assignment.getAdDirectoryRoleAssignment", "connection_error", err)
+		return nil, err
+	}
+
+	options := &rolemanagement.DirectoryRoleAssignmentsUnifiedRoleAssignmentItemRequestBuilderGetRequestConfiguration{
+		QueryParameters: &rolemanagement.DirectoryRoleAssignmentsUnifiedRoleAssignmentItemRequestBuilderGetQueryParameters{
+			Expand: []string{"roleDefinition($select=displayName)"},
+		},
+	}
+
+	roleAssignment, err := client.RoleManagement().Directory().RoleAssignments().ByUnifiedRoleAssignmentId(roleAssignmentId).Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getAdDirectoryRoleAssignment", "get_role_assignment_error", errObj)
+		return nil, errObj
+	}
+
+	return &ADDirectoryRoleAssignmentInfo{roleAssignment}, nil
+}
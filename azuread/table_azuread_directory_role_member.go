@@ -0,0 +1,106 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/directoryroles"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdDirectoryRoleMember(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_directory_role_member",
+		Description: "Represents a member of an activated Azure Active Directory (Azure AD) directory role.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdDirectoryRoleMembers,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "role_id", Require: plugin.Required},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "role_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the directory role.", Transform: transform.FromField("RoleId")},
+			{Name: "role_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the directory role.", Transform: transform.FromField("RoleDisplayName")},
+			{Name: "member_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the member.", Transform: transform.FromMethod("GetId")},
+			{Name: "member_type", Type: proto.ColumnType_STRING, Description: "The type of the member, e.g. user, group or servicePrincipal.", Transform: transform.FromMethod("DirectoryObjectType")},
+			{Name: "member_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the member.", Transform: transform.FromMethod("DirectoryObjectDisplayName")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adDirectoryRoleMemberTitle)},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdDirectoryRoleMembers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	roleId := d.EqualsQuals["role_id"].GetStringValue()
+	if roleId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_directory_role_member.listAdDirectoryRoleMembers", "connection_error", err)
+		return nil, err
+	}
+
+	roleOptions := &directoryroles.DirectoryRoleItemRequestBuilderGetRequestConfiguration{
+		QueryParameters: &directoryroles.DirectoryRoleItemRequestBuilderGetQueryParameters{
+			Select: []string{"id", "displayName"},
+		},
+	}
+
+	directoryRole, err := client.DirectoryRoles().ByDirectoryRoleId(roleId).Get(ctx, roleOptions)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdDirectoryRoleMembers", "get_directory_role_error", errObj)
+		return nil, errObj
+	}
+
+	result, err := client.DirectoryRoles().ByDirectoryRoleId(roleId).Members().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdDirectoryRoleMembers", "list_directory_role_member_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateDirectoryObjectCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.DirectoryObjectable) bool {
+		d.StreamListItem(ctx, &ADDirectoryRoleMemberInfo{ADDirectoryObjectInfo{pageItem}, &roleId, directoryRole.GetDisplayName()})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdDirectoryRoleMembers", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func adDirectoryRoleMemberTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADDirectoryRoleMemberInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	title := data.DirectoryObjectDisplayName()
+	if title == nil {
+		title = data.GetId()
+	}
+
+	return title, nil
+}
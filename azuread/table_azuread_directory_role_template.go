@@ -0,0 +1,126 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdDirectoryRoleTemplate(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_directory_role_template",
+		Description: "Represents a built-in directory role template, with whether the role is activated in the tenant.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdDirectoryRoleTemplates,
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the template, which is the role_template_id of the directory role activated from it.", Transform: transform.FromMethod("GetId")},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name of the role.", Transform: transform.FromMethod("GetDisplayName")},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the role.", Transform: transform.FromMethod("GetDescription")},
+			{Name: "is_activated", Type: proto.ColumnType_BOOL, Description: "Indicates whether the role is activated in the tenant, i.e. whether it has been assigned at least once.", Transform: transform.FromMethod("DirectoryRoleTemplateIsActivated")},
+			{Name: "role_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the directory role activated from the template. Null when the role is not activated.", Transform: transform.FromField("RoleId")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetDisplayName")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdDirectoryRoleTemplates(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	templates, err := getDirectoryRoleTemplatesMemoized(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	activatedRoleIds, err := getActivatedDirectoryRoleIds(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, template := range templates.([]models.DirectoryRoleTemplateable) {
+		var roleId *string
+		if template.GetId() != nil {
+			if id, ok := activatedRoleIds[*template.GetId()]; ok {
+				roleId = &id
+			}
+		}
+
+		d.StreamListItem(ctx, &ADDirectoryRoleTemplateInfo{template, roleId})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// The templates are the built-in roles, which only change with the releases of Azure AD, so they are fetched once per connection
+var getDirectoryRoleTemplatesMemoized = plugin.HydrateFunc(getDirectoryRoleTemplatesUncached).Memoize()
+
+func getDirectoryRoleTemplatesUncached(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_directory_role_template.getDirectoryRoleTemplatesUncached", "connection_error", err)
+		return nil, err
+	}
+
+	result, err := client.DirectoryRoleTemplates().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getDirectoryRoleTemplatesUncached", "list_directory_role_template_error", errObj)
+		return nil, errObj
+	}
+
+	templates := []models.DirectoryRoleTemplateable{}
+	err = iteratePages(ctx, d, adapter, result, models.CreateDirectoryRoleTemplateCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.DirectoryRoleTemplateable) bool {
+		templates = append(templates, pageItem)
+		return true
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("getDirectoryRoleTemplatesUncached", "paging_error", err)
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// getActivatedDirectoryRoleIds returns the IDs of the activated directory roles, keyed by their role template ID.
+// The activated roles change when a role is first assigned, so they are not cached.
+func getActivatedDirectoryRoleIds(ctx context.Context, d *plugin.QueryData) (map[string]string, error) {
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_directory_role_template.getActivatedDirectoryRoleIds", "connection_error", err)
+		return nil, err
+	}
+
+	result, err := client.DirectoryRoles().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getActivatedDirectoryRoleIds", "list_directory_role_error", errObj)
+		return nil, errObj
+	}
+
+	roleIds := map[string]string{}
+	for _, directoryRole := range result.GetValue() {
+		if directoryRole.GetRoleTemplateId() != nil && directoryRole.GetId() != nil {
+			roleIds[*directoryRole.GetRoleTemplateId()] = *directoryRole.GetId()
+		}
+	}
+
+	return roleIds, nil
+}
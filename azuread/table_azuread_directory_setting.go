@@ -3,7 +3,6 @@ package azuread
 import (
 	"context"
 
-	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
@@ -53,13 +52,7 @@ func listAdDirectorySetting(ctx context.Context, d *plugin.QueryData, _ *plugin.
 		return nil, errObj
 	}
 
-	pageIterator, err := msgraphcore.NewPageIterator[models.GroupSettingable](result, adapter, models.CreateGroupSettingCollectionResponseFromDiscriminatorValue)
-	if err != nil {
-		plugin.Logger(ctx).Error("listAdDirectorySetting", "create_iterator_instance_error", err)
-		return nil, err
-	}
-
-	err = pageIterator.Iterate(ctx, func(pageItem models.GroupSettingable) bool {
+	err = iteratePages(ctx, d, adapter, result, models.CreateGroupSettingCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.GroupSettingable) bool {
 		for _, s := range pageItem.GetValues() {
 			d.StreamListItem(ctx, &ADDirectorySettingInfo{
 				DisplayName: pageItem.GetDisplayName(),
@@ -68,6 +61,11 @@ func listAdDirectorySetting(ctx context.Context, d *plugin.QueryData, _ *plugin.
 				Name:        s.GetName(),
 				Value:       s.GetValue(),
 			})
+
+			// A single group setting can hold many values, so stop streaming as soon as the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return false
+			}
 		}
 
 		// Context can be cancelled due to manual cancellation or the limit has been hit
@@ -6,7 +6,6 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
-	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
 	"github.com/microsoftgraph/msgraph-sdk-go/domains"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 
@@ -90,13 +89,7 @@ func listAdDomains(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 		return nil, errObj
 	}
 
-	pageIterator, err := msgraphcore.NewPageIterator[models.Domainable](result, adapter, models.CreateDomainCollectionResponseFromDiscriminatorValue)
-	if err != nil {
-		plugin.Logger(ctx).Error("listAdDomains", "create_iterator_instance_error", err)
-		return nil, err
-	}
-
-	err = pageIterator.Iterate(ctx, func(pageItem models.Domainable) bool {
+	err = iteratePages(ctx, d, adapter, result, models.CreateDomainCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.Domainable) bool {
 		d.StreamListItem(ctx, &ADDomainInfo{pageItem})
 
 		// Context can be cancelled due to manual cancellation or the limit has been hit
@@ -0,0 +1,85 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdDomainVerificationDnsRecord(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_domain_verification_dns_record",
+		Description: "Represents a DNS record that must be published to verify the ownership of an Azure Active Directory (Azure AD) domain.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdDomainVerificationDnsRecords,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "domain_id", Require: plugin.Required},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "domain_id", Type: proto.ColumnType_STRING, Description: "The fully qualified name of the domain.", Transform: transform.FromField("DomainId")},
+			{Name: "record_type", Type: proto.ColumnType_STRING, Description: "Indicates what type of DNS record this entity represents. The value can be Txt or Mx.", Transform: transform.FromMethod("GetRecordType")},
+			{Name: "label", Type: proto.ColumnType_STRING, Description: "Value used when configuring the name of the DNS record at the DNS host.", Transform: transform.FromMethod("GetLabel")},
+			{Name: "text", Type: proto.ColumnType_STRING, Description: "Value used when configuring the text property at the DNS host. Only set for Txt records.", Transform: transform.FromMethod("DomainDnsRecordText")},
+
+			// Other fields
+			{Name: "ttl", Type: proto.ColumnType_INT, Description: "Value to use when configuring the time-to-live (ttl) property of the DNS record at the DNS host.", Transform: transform.FromMethod("GetTtl")},
+			{Name: "is_optional", Type: proto.ColumnType_BOOL, Description: "If false, this record must be configured by the customer at the DNS host for Microsoft Online Services to operate correctly with the domain.", Transform: transform.FromMethod("GetIsOptional")},
+			{Name: "supported_service", Type: proto.ColumnType_STRING, Description: "Microsoft Online Service or feature that has a dependency on this DNS record.", Transform: transform.FromMethod("GetSupportedService")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetLabel")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdDomainVerificationDnsRecords(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	domainId := d.EqualsQuals["domain_id"].GetStringValue()
+	if domainId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_domain_verification_dns_record.listAdDomainVerificationDnsRecords", "connection_error", err)
+		return nil, err
+	}
+
+	result, err := client.Domains().ByDomainId(domainId).VerificationDnsRecords().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdDomainVerificationDnsRecords", "list_domain_verification_dns_record_error", errObj)
+		return nil, errObj
+	}
+
+	// Domains that are already verified may not return any verification records
+	if result == nil || len(result.GetValue()) == 0 {
+		return nil, nil
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateDomainDnsRecordCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.DomainDnsRecordable) bool {
+		d.StreamListItem(ctx, &ADDomainDnsRecordInfo{pageItem, &domainId})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdDomainVerificationDnsRecords", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,147 @@
+package azuread
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/identitygovernance"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdEntitlementManagementAccessPackage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_entitlement_management_access_package",
+		Description: "Represents an access package in Azure AD entitlement management, which bundles the resources users can request access to.",
+		Get: &plugin.GetConfig{
+			Hydrate: getAdEntitlementManagementAccessPackage,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAdEntitlementManagementAccessPackages,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_UnsupportedQuery"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "catalog_id", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the access package.", Transform: transform.FromMethod("GetId")},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name of the access package.", Transform: transform.FromMethod("GetDisplayName")},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the access package.", Transform: transform.FromMethod("GetDescription")},
+			{Name: "catalog_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the catalog which contains the access package.", Transform: transform.FromMethod("AccessPackageCatalogId")},
+
+			// Other fields
+			{Name: "is_hidden", Type: proto.ColumnType_BOOL, Description: "Whether the access package is hidden from the requestor.", Transform: transform.FromMethod("GetIsHidden")},
+			{Name: "created_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the access package was created. The Timestamp type represents date and time information using ISO 8601 format and is always in UTC time.", Transform: transform.FromMethod("GetCreatedDateTime")},
+			{Name: "modified_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the access package was last modified. The Timestamp type represents date and time information using ISO 8601 format and is always in UTC time.", Transform: transform.FromMethod("GetModifiedDateTime")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adEntitlementManagementAccessPackageTitle)},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdEntitlementManagementAccessPackages(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_entitlement_management_access_package.listAdEntitlementManagementAccessPackages", "connection_error", err)
+		return nil, err
+	}
+
+	// List operations
+	// The catalog is a navigation property, so it must be expanded to populate the catalog_id column
+	input := &identitygovernance.EntitlementManagementAccessPackagesRequestBuilderGetQueryParameters{
+		Expand: []string{"catalog"},
+	}
+
+	catalogId := d.EqualsQuals["catalog_id"].GetStringValue()
+	if catalogId != "" {
+		filter := fmt.Sprintf("catalog/id eq '%s'", escapeODataString(catalogId))
+		input.Filter = &filter
+	}
+
+	options := &identitygovernance.EntitlementManagementAccessPackagesRequestBuilderGetRequestConfiguration{
+		QueryParameters: input,
+	}
+
+	result, err := client.IdentityGovernance().EntitlementManagement().AccessPackages().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdEntitlementManagementAccessPackages", "list_access_package_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateAccessPackageCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.AccessPackageable) bool {
+		d.StreamListItem(ctx, &ADAccessPackageInfo{pageItem})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdEntitlementManagementAccessPackages", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAdEntitlementManagementAccessPackage(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	accessPackageId := d.EqualsQuals["id"].GetStringValue()
+	if accessPackageId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_entitlement_management_access_package.getAdEntitlementManagementAccessPackage", "connection_error", err)
+		return nil, err
+	}
+
+	options := &identitygovernance.EntitlementManagementAccessPackagesAccessPackageItemRequestBuilderGetRequestConfiguration{
+		QueryParameters: &identitygovernance.EntitlementManagementAccessPackagesAccessPackageItemRequestBuilderGetQueryParameters{
+			Expand: []string{"catalog"},
+		},
+	}
+
+	accessPackage, err := client.IdentityGovernance().EntitlementManagement().AccessPackages().ByAccessPackageId(accessPackageId).Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getAdEntitlementManagementAccessPackage", "get_access_package_error", errObj)
+		return nil, errObj
+	}
+
+	return &ADAccessPackageInfo{accessPackage}, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func adEntitlementManagementAccessPackageTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADAccessPackageInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	title := data.GetDisplayName()
+	if title == nil {
+		title = data.GetId()
+	}
+
+	return title, nil
+}
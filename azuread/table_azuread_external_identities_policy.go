@@ -0,0 +1,109 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdExternalIdentitiesPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_external_identities_policy",
+		Description: "Represents the settings of the tenant for the external identities, such as whether guest users can sign up through a self-service sign-up user flow.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdExternalIdentitiesPolicies,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "ResourceNotFound"}),
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: listAdExternalIdentitiesPolicyUserFlows,
+				// The user flows are not available in all the tenants
+				IgnoreConfig: &plugin.IgnoreConfig{
+					ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "ResourceNotFound"}),
+				},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The identifier of the authentication flows policy.", Transform: transform.FromMethod("GetId")},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name of the authentication flows policy.", Transform: transform.FromMethod("GetDisplayName")},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the authentication flows policy.", Transform: transform.FromMethod("GetDescription")},
+			{Name: "self_service_sign_up_enabled", Type: proto.ColumnType_BOOL, Description: "Indicates whether the guest users can sign up to the applications of the tenant through a self-service sign-up user flow.", Transform: transform.FromMethod("ExternalIdentitiesPolicySelfServiceSignUpEnabled")},
+
+			// JSON fields
+			{Name: "self_service_sign_up_user_flows", Type: proto.ColumnType_JSON, Description: "The self-service sign-up user flows of the tenant, with their type and version. Null for the tenants where the user flows are not available.", Hydrate: listAdExternalIdentitiesPolicyUserFlows, Transform: transform.FromValue()},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetDisplayName")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdExternalIdentitiesPolicies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_external_identities_policy.listAdExternalIdentitiesPolicies", "connection_error", err)
+		return nil, err
+	}
+
+	result, err := client.Policies().AuthenticationFlowsPolicy().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdExternalIdentitiesPolicies", "get_authentication_flows_policy_error", errObj)
+		return nil, errObj
+	}
+	d.StreamListItem(ctx, &ADExternalIdentitiesPolicyInfo{result})
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func listAdExternalIdentitiesPolicyUserFlows(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_external_identities_policy.listAdExternalIdentitiesPolicyUserFlows", "connection_error", err)
+		return nil, err
+	}
+
+	result, err := client.Identity().B2xUserFlows().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdExternalIdentitiesPolicyUserFlows", "list_b2x_user_flow_error", errObj)
+		return nil, errObj
+	}
+
+	userFlows := []map[string]interface{}{}
+	err = iteratePages(ctx, d, adapter, result, models.CreateB2xIdentityUserFlowCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.B2xIdentityUserFlowable) bool {
+		userFlow := map[string]interface{}{}
+		if pageItem.GetId() != nil {
+			userFlow["id"] = *pageItem.GetId()
+		}
+		if pageItem.GetUserFlowType() != nil {
+			userFlow["userFlowType"] = pageItem.GetUserFlowType().String()
+		}
+		if pageItem.GetUserFlowTypeVersion() != nil {
+			userFlow["userFlowTypeVersion"] = *pageItem.GetUserFlowTypeVersion()
+		}
+		userFlows = append(userFlows, userFlow)
+		return true
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdExternalIdentitiesPolicyUserFlows", "paging_error", err)
+		return nil, err
+	}
+
+	return userFlows, nil
+}
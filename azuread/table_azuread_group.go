@@ -8,7 +8,6 @@ import (
 	"github.com/iancoleman/strcase"
 
 	abstractions "github.com/microsoft/kiota-abstractions-go"
-	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
 	"github.com/microsoftgraph/msgraph-sdk-go/groups"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 
@@ -37,14 +36,24 @@ func tableAzureAdGroup(_ context.Context) *plugin.Table {
 			},
 			KeyColumns: plugin.KeyColumnSlice{
 				// Key fields
-				{Name: "display_name", Require: plugin.Optional},
+				{Name: "display_name", Require: plugin.Optional, Operators: []string{"=", "~~", "~~*"}},
 				{Name: "filter", Require: plugin.Optional},
+				{Name: "is_assignable_to_role", Require: plugin.Optional},
 				{Name: "mail", Require: plugin.Optional},
 				{Name: "mail_enabled", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				{Name: "membership_type", Require: plugin.Optional},
 				{Name: "on_premises_sync_enabled", Require: plugin.Optional, Operators: []string{"<>", "="}},
 				{Name: "security_enabled", Require: plugin.Optional, Operators: []string{"<>", "="}},
 			},
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getAdGroupHasPhoto,
+				IgnoreConfig: &plugin.IgnoreConfig{
+					ShouldIgnoreErrorFunc: isIgnorableErrorPredicate(photoIgnorableErrors),
+				},
+			},
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The name displayed in the address book for the user. This is usually the combination of the user's first name, middle initial and last name.", Transform: transform.FromMethod("GetDisplayName")},
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier for the group.", Transform: transform.FromMethod("GetId")},
@@ -56,11 +65,16 @@ func tableAzureAdGroup(_ context.Context) *plugin.Table {
 			{Name: "created_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time at which the group was created.", Transform: transform.FromMethod("GetCreatedDateTime")},
 			{Name: "expiration_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "Timestamp of when the group is set to expire.", Transform: transform.FromMethod("GetExpirationDateTime")},
 			{Name: "is_assignable_to_role", Type: proto.ColumnType_BOOL, Description: "Indicates whether this group can be assigned to an Azure Active Directory role or not.", Transform: transform.FromMethod("GetIsAssignableToRole")},
+			{Name: "is_management_restricted", Type: proto.ColumnType_BOOL, Description: "Indicates whether the group is a member of a restricted management administrative unit, in which case only the administrators assigned to that unit can manage it.", Transform: transform.FromMethod("GroupIsManagementRestricted")},
+			{Name: "has_team", Type: proto.ColumnType_BOOL, Description: "True if the group is a Microsoft 365 group with a team in Microsoft Teams, i.e. its resource_provisioning_options contain Team.", Transform: transform.FromMethod("GroupHasTeam")},
+			{Name: "has_photo", Type: proto.ColumnType_BOOL, Description: "True if the group has a photo. Null if the photo can't be read, e.g. for a security group.", Hydrate: getAdGroupHasPhoto, Transform: transform.FromValue()},
 			{Name: "is_subscribed_by_mail", Type: proto.ColumnType_BOOL, Description: "Indicates whether the signed-in user is subscribed to receive email conversations. Default value is true.", Hydrate: getAdGroupIsSubscribedByMail, Transform: transform.FromValue()},
 			{Name: "mail", Type: proto.ColumnType_STRING, Description: "The SMTP address for the group, for example, \"serviceadmins@contoso.onmicrosoft.com\".", Transform: transform.FromMethod("GetMail")},
 			{Name: "mail_enabled", Type: proto.ColumnType_BOOL, Description: "Specifies whether the group is mail-enabled.", Transform: transform.FromMethod("GetMailEnabled")},
 			{Name: "mail_nickname", Type: proto.ColumnType_STRING, Description: "The mail alias for the user.", Transform: transform.FromMethod("GetMailNickname")},
-			{Name: "membership_rule", Type: proto.ColumnType_STRING, Description: "The mail alias for the group, unique in the organization.", Transform: transform.FromMethod("GetMembershipRule")},
+			{Name: "member_count", Type: proto.ColumnType_INT, Description: "The number of the direct members of the group, i.e. the members of the nested groups are not counted.", Hydrate: getAdGroupMemberCount, Transform: transform.FromValue()},
+			{Name: "membership_rule", Type: proto.ColumnType_STRING, Description: "The rule that determines members for this group if the group is a dynamic group (groupTypes contains DynamicMembership).", Transform: transform.FromMethod("GetMembershipRule")},
+			{Name: "membership_type", Type: proto.ColumnType_STRING, Description: "Indicates how the members of the group are managed. Possible values are DynamicMembership, if the members are determined by a membership rule, or Assigned.", Transform: transform.From(adGroupMembershipType)},
 			{Name: "membership_rule_processing_state", Type: proto.ColumnType_STRING, Description: "Indicates whether the dynamic membership processing is on or paused. Possible values are On or Paused.", Transform: transform.FromMethod("GetMembershipRuleProcessingState")},
 			{Name: "on_premises_domain_name", Type: proto.ColumnType_STRING, Description: "Contains the on-premises Domain name synchronized from the on-premises directory.", Transform: transform.FromMethod("GetOnPremisesDomainName")},
 			{Name: "on_premises_last_sync_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "Indicates the last time at which the group was synced with the on-premises directory.", Transform: transform.FromMethod("GetOnPremisesLastSyncDateTime")},
@@ -68,6 +82,7 @@ func tableAzureAdGroup(_ context.Context) *plugin.Table {
 			{Name: "on_premises_sam_account_name", Type: proto.ColumnType_STRING, Description: "Contains the on-premises SAM account name synchronized from the on-premises directory.", Transform: transform.FromMethod("GetOnPremisesSamAccountName")},
 			{Name: "on_premises_security_identifier", Type: proto.ColumnType_STRING, Description: "Contains the on-premises security identifier (SID) for the group that was synchronized from on-premises to the cloud.", Transform: transform.FromMethod("GetOnPremisesSecurityIdentifier")},
 			{Name: "on_premises_sync_enabled", Type: proto.ColumnType_BOOL, Description: "True if this group is synced from an on-premises directory; false if this group was originally synced from an on-premises directory but is no longer synced; null if this object has never been synced from an on-premises directory (default).", Transform: transform.FromMethod("GetOnPremisesSyncEnabled")},
+			{Name: "owner_count", Type: proto.ColumnType_INT, Description: "The number of the owners of the group.", Hydrate: getAdGroupOwnerCount, Transform: transform.FromValue()},
 			{Name: "renewed_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "Timestamp of when the group was last renewed. This cannot be modified directly and is only updated via the renew service action.", Transform: transform.FromMethod("GetRenewedDateTime")},
 			{Name: "security_enabled", Type: proto.ColumnType_BOOL, Description: "Specifies whether the group is a security group.", Transform: transform.FromMethod("GetSecurityEnabled")},
 			{Name: "security_identifier", Type: proto.ColumnType_STRING, Description: "Security identifier of the group, used in Windows scenarios.", Transform: transform.FromMethod("GetSecurityIdentifier")},
@@ -77,10 +92,12 @@ func tableAzureAdGroup(_ context.Context) *plugin.Table {
 			{Name: "assigned_labels", Type: proto.ColumnType_JSON, Description: "The list of sensitivity label pairs (label ID, label name) associated with a Microsoft 365 group.", Transform: transform.FromMethod("GroupAssignedLabels")},
 			{Name: "group_types", Type: proto.ColumnType_JSON, Description: "Specifies the group type and its membership. If the collection contains Unified, the group is a Microsoft 365 group; otherwise, it's either a security group or distribution group. For details, see [groups overview](https://docs.microsoft.com/en-us/graph/api/resources/groups-overview?view=graph-rest-1.0).", Transform: transform.FromMethod("GetGroupTypes")},
 			{Name: "member_ids", Type: proto.ColumnType_JSON, Hydrate: getAdGroupMembers, Transform: transform.FromValue(), Description: "Id of Users and groups that are members of this group."},
+			{Name: "on_premises_provisioning_errors", Type: proto.ColumnType_JSON, Description: "Errors when using Microsoft synchronization product during provisioning.", Transform: transform.FromMethod("GroupOnPremisesProvisioningErrors")},
 			{Name: "owner_ids", Type: proto.ColumnType_JSON, Hydrate: getAdGroupOwners, Transform: transform.FromValue(), Description: "Id od the owners of the group. The owners are a set of non-admin users who are allowed to modify this object."},
 			{Name: "proxy_addresses", Type: proto.ColumnType_JSON, Description: "Email addresses for the group that direct to the same group mailbox. For example: [\"SMTP: bob@contoso.com\", \"smtp: bob@sales.contoso.com\"]. The any operator is required to filter expressions on multi-valued properties.", Transform: transform.FromMethod("GetProxyAddresses")},
 			{Name: "resource_behavior_options", Type: proto.ColumnType_JSON, Description: "Specifies the group behaviors that can be set for a Microsoft 365 group during creation. Possible values are AllowOnlyMembersToPost, HideGroupInOutlook, SubscribeNewGroupMembers, WelcomeEmailDisabled."},
 			{Name: "resource_provisioning_options", Type: proto.ColumnType_JSON, Description: "Specifies the group resources that are provisioned as part of Microsoft 365 group creation, that are not normally part of default group creation. Possible value is Team."},
+			rawColumn(),
 
 			// Standard columns
 			{Name: "tags", Type: proto.ColumnType_JSON, Description: ColumnDescriptionTags, Transform: transform.From(adGroupTags)},
@@ -132,8 +149,22 @@ func listAdGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDat
 		input.Filter = &joinStr
 	}
 
+	// A like or ilike qual on display_name is sent to Graph as a $search, which requires the ConsistencyLevel header
+	headers := &abstractions.RequestHeaders{}
+	if search := buildDisplayNameSearch(quals); search != "" {
+		input.Search = &search
+		headers.Add("ConsistencyLevel", "eventual")
+	}
+
+	// Filtering on isAssignableToRole is an advanced query, which requires the ConsistencyLevel header and the $count parameter
+	if equalQuals["is_assignable_to_role"] != nil && queryFilter == "" {
+		input.Count = Bool(true)
+		headers.Add("ConsistencyLevel", "eventual")
+	}
+
 	options := &groups.GroupsRequestBuilderGetRequestConfiguration{
 		QueryParameters: input,
+		Headers:         headers,
 	}
 
 	result, err := client.Groups().Get(ctx, options)
@@ -143,13 +174,8 @@ func listAdGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDat
 		return nil, errObj
 	}
 
-	pageIterator, err := msgraphcore.NewPageIterator[models.Groupable](result, adapter, models.CreateGroupCollectionResponseFromDiscriminatorValue)
-	if err != nil {
-		plugin.Logger(ctx).Error("listAdGroups", "create_iterator_instance_error", err)
-		return nil, err
-	}
-
-	err = pageIterator.Iterate(ctx, func(pageItem models.Groupable) bool {
+	// The next page requests must carry the same headers as the first one
+	err = iteratePages(ctx, d, adapter, result, models.CreateGroupCollectionResponseFromDiscriminatorValue, headers, func(pageItem models.Groupable) bool {
 		resourceBehaviorOptions := formatResourceBehaviorOptions(ctx, pageItem)
 		resourceProvisioningOptions := formatResourceProvisioningOptions(ctx, pageItem)
 
@@ -239,6 +265,64 @@ func getAdGroupIsSubscribedByMail(ctx context.Context, d *plugin.QueryData, h *p
 	return group.GetIsSubscribedByMail(), nil
 }
 
+func getAdGroupHasPhoto(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	group := h.Item.(*ADGroupInfo)
+	if group.GetId() == nil {
+		return nil, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_group.getAdGroupHasPhoto", "connection_error", err)
+		return nil, err
+	}
+
+	// Only the metadata of the photo is read, not its content
+	_, err = client.Groups().ByGroupId(*group.GetId()).Photo().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		if isPhotoNotFoundError(errObj) {
+			return false, nil
+		}
+		plugin.Logger(ctx).Error("getAdGroupHasPhoto", "get_photo_error", errObj)
+		return nil, errObj
+	}
+
+	return true, nil
+}
+
+func getAdGroupMemberCount(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	group := h.Item.(*ADGroupInfo)
+	if group.GetId() == nil {
+		return nil, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_group.getAdGroupMemberCount", "connection_error", err)
+		return nil, err
+	}
+
+	// The $count of a collection requires the eventual consistency, and avoids reading the members themselves
+	headers := &abstractions.RequestHeaders{}
+	headers.Add("ConsistencyLevel", "eventual")
+
+	config := &groups.ItemMembersCountRequestBuilderGetRequestConfiguration{
+		Headers: headers,
+	}
+
+	count, err := client.Groups().ByGroupId(*group.GetId()).Members().Count().Get(ctx, config)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getAdGroupMemberCount", "get_group_member_count_error", errObj)
+		return nil, errObj
+	}
+
+	return count, nil
+}
+
 func getAdGroupMembers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	// Create client
 	client, adapter, err := GetGraphClient(ctx, d)
@@ -270,13 +354,7 @@ func getAdGroupMembers(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 		return nil, errObj
 	}
 
-	pageIterator, err := msgraphcore.NewPageIterator[models.DirectoryObjectable](members, adapter, models.CreateDirectoryObjectCollectionResponseFromDiscriminatorValue)
-	if err != nil {
-		plugin.Logger(ctx).Error("getAdGroupMembers", "create_iterator_instance_error", err)
-		return nil, err
-	}
-
-	err = pageIterator.Iterate(ctx, func(pageItem models.DirectoryObjectable) bool {
+	err = iteratePages(ctx, d, adapter, members, models.CreateDirectoryObjectCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.DirectoryObjectable) bool {
 		memberIds = append(memberIds, pageItem.GetId())
 
 		return true
@@ -289,6 +367,37 @@ func getAdGroupMembers(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 	return memberIds, nil
 }
 
+func getAdGroupOwnerCount(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	group := h.Item.(*ADGroupInfo)
+	if group.GetId() == nil {
+		return nil, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_group.getAdGroupOwnerCount", "connection_error", err)
+		return nil, err
+	}
+
+	// The $count of a collection requires the eventual consistency, and avoids reading the owners themselves
+	headers := &abstractions.RequestHeaders{}
+	headers.Add("ConsistencyLevel", "eventual")
+
+	config := &groups.ItemOwnersCountRequestBuilderGetRequestConfiguration{
+		Headers: headers,
+	}
+
+	count, err := client.Groups().ByGroupId(*group.GetId()).Owners().Count().Get(ctx, config)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getAdGroupOwnerCount", "get_group_owner_count_error", errObj)
+		return nil, errObj
+	}
+
+	return count, nil
+}
+
 func getAdGroupOwners(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	// Create client
 	client, adapter, err := GetGraphClient(ctx, d)
@@ -320,13 +429,7 @@ func getAdGroupOwners(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 		return nil, errObj
 	}
 
-	pageIterator, err := msgraphcore.NewPageIterator[models.DirectoryObjectable](owners, adapter, models.CreateDirectoryObjectCollectionResponseFromDiscriminatorValue)
-	if err != nil {
-		plugin.Logger(ctx).Error("getAdGroupOwners", "create_iterator_instance_error", err)
-		return nil, err
-	}
-
-	err = pageIterator.Iterate(ctx, func(pageItem models.DirectoryObjectable) bool {
+	err = iteratePages(ctx, d, adapter, owners, models.CreateDirectoryObjectCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.DirectoryObjectable) bool {
 		ownerIds = append(ownerIds, pageItem.GetId())
 
 		return true
@@ -374,11 +477,27 @@ func adGroupTitle(_ context.Context, d *transform.TransformData) (interface{}, e
 	return title, nil
 }
 
+func adGroupMembershipType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADGroupInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	for _, groupType := range data.GetGroupTypes() {
+		if groupType == "DynamicMembership" {
+			return "DynamicMembership", nil
+		}
+	}
+
+	return "Assigned", nil
+}
+
 func buildGroupQueryFilter(equalQuals plugin.KeyColumnEqualsQualMap) []string {
 	filters := []string{}
 
 	filterQuals := map[string]string{
 		"display_name":             "string",
+		"is_assignable_to_role":    "bool",
 		"mail":                     "string",
 		"mail_enabled":             "bool",
 		"on_premises_sync_enabled": "bool",
@@ -389,7 +508,7 @@ func buildGroupQueryFilter(equalQuals plugin.KeyColumnEqualsQualMap) []string {
 		switch qualType {
 		case "string":
 			if equalQuals[qual] != nil {
-				filters = append(filters, fmt.Sprintf("%s eq '%s'", strcase.ToCamel(qual), equalQuals[qual].GetStringValue()))
+				filters = append(filters, fmt.Sprintf("%s eq '%s'", strcase.ToCamel(qual), escapeODataString(equalQuals[qual].GetStringValue())))
 			}
 		case "bool":
 			if equalQuals[qual] != nil {
@@ -398,6 +517,11 @@ func buildGroupQueryFilter(equalQuals plugin.KeyColumnEqualsQualMap) []string {
 		}
 	}
 
+	// Filtering out dynamic groups requires an advanced query, so only dynamic groups are filtered server side
+	if equalQuals["membership_type"] != nil && equalQuals["membership_type"].GetStringValue() == "DynamicMembership" {
+		filters = append(filters, "groupTypes/any(c:c eq 'DynamicMembership')")
+	}
+
 	return filters
 }
 
@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/iancoleman/strcase"
-	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
 	"github.com/microsoftgraph/msgraph-sdk-go/groups"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 
@@ -112,13 +111,7 @@ func listAdGroupAppRoleAssignments(ctx context.Context, d *plugin.QueryData, _ *
 		return nil, errObj
 	}
 
-	pageIterator, err := msgraphcore.NewPageIterator[models.AppRoleAssignmentable](result, adapter, models.CreateAppRoleAssignmentCollectionResponseFromDiscriminatorValue)
-	if err != nil {
-		plugin.Logger(ctx).Error("listAdGroupAppRoleAssignments", "create_iterator_instance_error", err)
-		return nil, err
-	}
-
-	err = pageIterator.Iterate(ctx, func(pageItem models.AppRoleAssignmentable) bool {
+	err = iteratePages(ctx, d, adapter, result, models.CreateAppRoleAssignmentCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.AppRoleAssignmentable) bool {
 		d.StreamListItem(ctx, &ADAppRoleAssignmentInfo{pageItem})
 
 		// Context can be cancelled due to manual cancellation or the limit has been hit
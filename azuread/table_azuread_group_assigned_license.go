@@ -0,0 +1,89 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/groups"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdGroupAssignedLicense(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_group_assigned_license",
+		Description: "Represents a license assigned to a group, which is in turn assigned to the members of the group by group-based licensing.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdGroupAssignedLicenses,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "group_id", Require: plugin.Required},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "group_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the group the license is assigned to.", Transform: transform.FromField("GroupId")},
+			{Name: "sku_id", Type: proto.ColumnType_STRING, Description: "The unique identifier for the SKU of the license.", Transform: transform.FromMethod("GroupAssignedLicenseSkuId")},
+			{Name: "processing_state", Type: proto.ColumnType_STRING, Description: "The state of the processing of the licenses of the group. Possible values are: QueuedForProcessing, ProcessingInProgress, ProcessingComplete.", Transform: transform.FromField("ProcessingState")},
+
+			// JSON fields
+			{Name: "disabled_plans", Type: proto.ColumnType_JSON, Description: "A collection of the unique identifiers for the plans that have been disabled in the license.", Transform: transform.FromMethod("GroupAssignedLicenseDisabledPlans")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GroupAssignedLicenseSkuId")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdGroupAssignedLicenses(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	groupId := d.EqualsQuals["group_id"].GetStringValue()
+	if groupId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_group_assigned_license.listAdGroupAssignedLicenses", "connection_error", err)
+		return nil, err
+	}
+
+	// The licenses are only returned when explicitly selected
+	input := &groups.GroupItemRequestBuilderGetQueryParameters{
+		Select: []string{"id", "assignedLicenses", "licenseProcessingState"},
+	}
+
+	options := &groups.GroupItemRequestBuilderGetRequestConfiguration{
+		QueryParameters: input,
+	}
+
+	group, err := client.Groups().ByGroupId(groupId).Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdGroupAssignedLicenses", "get_group_error", errObj)
+		return nil, errObj
+	}
+
+	var processingState *string
+	if group.GetLicenseProcessingState() != nil {
+		processingState = group.GetLicenseProcessingState().GetState()
+	}
+
+	for _, license := range group.GetAssignedLicenses() {
+		d.StreamListItem(ctx, &ADGroupAssignedLicenseInfo{license, &groupId, processingState})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
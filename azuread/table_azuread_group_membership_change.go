@@ -0,0 +1,216 @@
+package azuread
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/auditlogs"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// The activities recorded in the directory audit logs when a member is added to or removed from a group
+var groupMembershipChangeActivities = map[string]string{
+	"add":    "Add member to group",
+	"remove": "Remove member from group",
+}
+
+//// TABLE DEFINITION
+
+func tableAzureAdGroupMembershipChange(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_group_membership_change",
+		Description: "Represents a member added to or removed from a group, as recorded in the Azure AD directory audit logs.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdGroupMembershipChanges,
+			KeyColumns: plugin.KeyColumnSlice{
+				// Key fields
+				{Name: "activity_date_time", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
+				{Name: "action", Require: plugin.Optional},
+				{Name: "group_id", Require: plugin.Optional},
+				{Name: "member_id", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the audit event.", Transform: transform.FromMethod("GetId")},
+			{Name: "activity_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the membership changed.", Transform: transform.FromMethod("GetActivityDateTime")},
+			{Name: "action", Type: proto.ColumnType_STRING, Description: "The change of the membership. Possible values are: add, remove.", Transform: transform.FromField("Action")},
+			{Name: "group_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the group.", Transform: transform.FromField("GroupId")},
+			{Name: "group_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the group when the membership changed.", Transform: transform.FromField("GroupDisplayName")},
+			{Name: "member_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the member added to or removed from the group.", Transform: transform.FromField("MemberId")},
+			{Name: "member_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the member, or its user principal name when the display name is not recorded.", Transform: transform.FromField("MemberDisplayName")},
+			{Name: "member_type", Type: proto.ColumnType_STRING, Description: "The type of the member, e.g. User, Group or ServicePrincipal.", Transform: transform.FromField("MemberType")},
+
+			// Other fields
+			{Name: "result", Type: proto.ColumnType_STRING, Description: "The result of the activity. Possible values are: success, failure, timeout, unknownFutureValue.", Transform: transform.FromMethod("DirectoryAuditResult")},
+			{Name: "correlation_id", Type: proto.ColumnType_STRING, Description: "The unique identifier which correlates the activities of a same operation across services.", Transform: transform.FromMethod("GetCorrelationId")},
+			{Name: "initiated_by_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the user or the app which changed the membership.", Transform: transform.FromMethod("DirectoryAuditInitiatedByDisplayName")},
+
+			// JSON fields
+			{Name: "initiated_by", Type: proto.ColumnType_JSON, Description: "The user or the app which changed the membership.", Transform: transform.FromMethod("DirectoryAuditInitiatedBy")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetId")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdGroupMembershipChanges(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_group_membership_change.listAdGroupMembershipChanges", "connection_error", err)
+		return nil, err
+	}
+
+	// List operations
+	input := &auditlogs.DirectoryAuditsRequestBuilderGetQueryParameters{
+		Top: Int32(1000),
+	}
+
+	// Restrict the limit value to be passed in the query parameter which is not between 1 and 1000, otherwise API will throw an error as follow
+	// The limit of '1000' for Top query has been exceeded.
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 1000 {
+			l := int32(*limit)
+			input.Top = Int32(l)
+		}
+	}
+
+	var filter []string
+
+	// Only the membership activities are requested, either both or the one of the given action
+	if d.EqualsQuals["action"] != nil {
+		activity, ok := groupMembershipChangeActivities[d.EqualsQuals["action"].GetStringValue()]
+		if !ok {
+			return nil, nil
+		}
+		filter = append(filter, fmt.Sprintf("activityDisplayName eq '%s'", activity))
+	} else {
+		filter = append(filter, fmt.Sprintf("(activityDisplayName eq '%s' or activityDisplayName eq '%s')", groupMembershipChangeActivities["add"], groupMembershipChangeActivities["remove"]))
+	}
+
+	// The group and the member are both target resources of the activity
+	for _, qual := range []string{"group_id", "member_id"} {
+		if d.EqualsQuals[qual] != nil {
+			filter = append(filter, fmt.Sprintf("targetResources/any(t:t/id eq '%s')", escapeODataString(d.EqualsQuals[qual].GetStringValue())))
+		}
+	}
+
+	// Filter by activityDateTime
+	if d.Quals["activity_date_time"] != nil {
+		for _, q := range d.Quals["activity_date_time"].Quals {
+			givenTime := q.Value.GetTimestampValue().AsTime()
+
+			switch q.Operator {
+			case ">":
+				startTime := givenTime.Add(time.Second * 1).Format(time.RFC3339)
+				filter = append(filter, fmt.Sprintf("activityDateTime ge %s", startTime))
+			case ">=":
+				filter = append(filter, fmt.Sprintf("activityDateTime ge %s", givenTime.Format(time.RFC3339)))
+			case "=":
+				filter = append(filter, fmt.Sprintf("activityDateTime eq %s", givenTime.Format(time.RFC3339)))
+			case "<=":
+				filter = append(filter, fmt.Sprintf("activityDateTime le %s", givenTime.Format(time.RFC3339)))
+			case "<":
+				startTime := givenTime.Add(time.Duration(-1) * time.Second).Format(time.RFC3339)
+				filter = append(filter, fmt.Sprintf("activityDateTime le %s", startTime))
+			}
+		}
+	}
+
+	joinStr := strings.Join(filter, " and ")
+	input.Filter = &joinStr
+
+	options := &auditlogs.DirectoryAuditsRequestBuilderGetRequestConfiguration{
+		QueryParameters: input,
+	}
+
+	result, err := client.AuditLogs().DirectoryAudits().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdGroupMembershipChanges", "list_directory_audit_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateDirectoryAuditCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.DirectoryAuditable) bool {
+		d.StreamListItem(ctx, newADGroupMembershipChangeInfo(pageItem))
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdGroupMembershipChanges", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// newADGroupMembershipChangeInfo reads the group and the member from the target resources of a membership activity.
+// The member is the first target resource, and the group is the second one. The name of the group is only recorded
+// in the modified properties of the member, as the Group.DisplayName property.
+func newADGroupMembershipChangeInfo(directoryAudit models.DirectoryAuditable) *ADGroupMembershipChangeInfo {
+	change := &ADGroupMembershipChangeInfo{ADDirectoryAuditReportInfo: ADDirectoryAuditReportInfo{directoryAudit}}
+
+	for action, activity := range groupMembershipChangeActivities {
+		if directoryAudit.GetActivityDisplayName() != nil && *directoryAudit.GetActivityDisplayName() == activity {
+			change.Action = action
+		}
+	}
+
+	targetResources := directoryAudit.GetTargetResources()
+	if len(targetResources) > 0 {
+		member := targetResources[0]
+		change.MemberId = member.GetId()
+		change.MemberType = member.GetTypeEscaped()
+		change.MemberDisplayName = member.GetDisplayName()
+		if change.MemberDisplayName == nil {
+			change.MemberDisplayName = member.GetUserPrincipalName()
+		}
+
+		for _, property := range member.GetModifiedProperties() {
+			if property.GetDisplayName() == nil {
+				continue
+			}
+
+			// The values are JSON strings, e.g. "\"Finance\"", holding the new value on an addition and the old value on a removal
+			value := property.GetNewValue()
+			if change.Action == "remove" {
+				value = property.GetOldValue()
+			}
+			if value == nil {
+				continue
+			}
+			unquoted := strings.Trim(*value, "\"")
+
+			switch *property.GetDisplayName() {
+			case "Group.ObjectID":
+				change.GroupId = &unquoted
+			case "Group.DisplayName":
+				change.GroupDisplayName = &unquoted
+			}
+		}
+	}
+
+	if len(targetResources) > 1 {
+		group := targetResources[1]
+		if group.GetId() != nil {
+			change.GroupId = group.GetId()
+		}
+		if group.GetDisplayName() != nil {
+			change.GroupDisplayName = group.GetDisplayName()
+		}
+	}
+
+	return change
+}
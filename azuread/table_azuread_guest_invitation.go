@@ -0,0 +1,162 @@
+package azuread
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/auditlogs"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/users"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdGuestInvitation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_guest_invitation",
+		Description: "Represents a guest user invited to the Azure AD tenant, with the state of the invitation.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdGuestInvitations,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "external_user_state", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier for the guest user.", Transform: transform.FromMethod("GetId")},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The name displayed in the address book for the guest user.", Transform: transform.FromMethod("GetDisplayName")},
+			{Name: "user_principal_name", Type: proto.ColumnType_STRING, Description: "The user principal name (UPN) of the guest user.", Transform: transform.FromMethod("GetUserPrincipalName")},
+			{Name: "mail", Type: proto.ColumnType_STRING, Description: "The SMTP address of the guest user, usually the address the invitation was sent to.", Transform: transform.FromMethod("GetMail")},
+			{Name: "external_user_state", Type: proto.ColumnType_STRING, Description: "The state of the invitation. Possible values are: PendingAcceptance, Accepted.", Transform: transform.FromMethod("GetExternalUserState")},
+			{Name: "external_user_state_change_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time at which the external_user_state last changed.", Transform: transform.FromMethod("GetExternalUserStateChangeDateTime")},
+			{Name: "created_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time at which the guest user was created, i.e. when the invitation was sent.", Transform: transform.FromMethod("GetCreatedDateTime")},
+
+			// JSON fields
+			{Name: "invited_by", Type: proto.ColumnType_JSON, Description: "The user or the application which invited the guest user, as recorded in the directory audit logs. Null when the invitation is older than the audit log retention period.", Hydrate: getAdGuestInvitationInvitedBy, Transform: transform.FromValue()},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adGuestInvitationTitle)},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdGuestInvitations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_guest_invitation.listAdGuestInvitations", "connection_error", err)
+		return nil, err
+	}
+
+	// List operations
+	input := &users.UsersRequestBuilderGetQueryParameters{
+		Top:    Int32(999),
+		Select: []string{"id", "displayName", "userPrincipalName", "mail", "externalUserState", "externalUserStateChangeDateTime", "createdDateTime"},
+	}
+
+	// Restrict the limit value to be passed in the query parameter which is not between 1 and 999, otherwise API will throw an error as follow
+	// unexpected status 400 with OData error: Request_UnsupportedQuery: Invalid page size specified: '1000'. Must be between 1 and 999 inclusive.
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 999 {
+			l := int32(*limit)
+			input.Top = Int32(l)
+		}
+	}
+
+	// Only the users created by redeeming an invitation are returned
+	filter := []string{"creationType eq 'Invitation'"}
+	if d.EqualsQuals["external_user_state"] != nil {
+		filter = append(filter, fmt.Sprintf("externalUserState eq '%s'", escapeODataString(d.EqualsQuals["external_user_state"].GetStringValue())))
+	}
+
+	joinStr := strings.Join(filter, " and ")
+	input.Filter = &joinStr
+
+	options := &users.UsersRequestBuilderGetRequestConfiguration{
+		QueryParameters: input,
+	}
+
+	result, err := client.Users().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdGuestInvitations", "list_guest_invitation_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateUserCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.Userable) bool {
+		d.StreamListItem(ctx, &ADGuestInvitationInfo{pageItem})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdGuestInvitations", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAdGuestInvitationInvitedBy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	guest := h.Item.(*ADGuestInvitationInfo)
+	if guest.GetId() == nil {
+		return nil, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_guest_invitation.getAdGuestInvitationInvitedBy", "connection_error", err)
+		return nil, err
+	}
+
+	// The invitations can't be listed, so the inviter is read from the audit event recorded when the guest user was invited
+	filter := fmt.Sprintf("activityDisplayName eq 'Invite external user' and targetResources/any(t: t/id eq '%s')", *guest.GetId())
+	options := &auditlogs.DirectoryAuditsRequestBuilderGetRequestConfiguration{
+		QueryParameters: &auditlogs.DirectoryAuditsRequestBuilderGetQueryParameters{
+			Filter: &filter,
+			Top:    Int32(1),
+		},
+	}
+
+	result, err := client.AuditLogs().DirectoryAudits().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getAdGuestInvitationInvitedBy", "list_directory_audit_error", errObj)
+		return nil, errObj
+	}
+
+	// The audit logs are only retained for a limited period, so older invitations have no event
+	if len(result.GetValue()) == 0 {
+		return nil, nil
+	}
+
+	directoryAudit := &ADDirectoryAuditReportInfo{result.GetValue()[0]}
+	return directoryAudit.DirectoryAuditInitiatedBy(), nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func adGuestInvitationTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADGuestInvitationInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	title := data.GetDisplayName()
+	if title == nil {
+		title = data.GetUserPrincipalName()
+	}
+
+	return title, nil
+}
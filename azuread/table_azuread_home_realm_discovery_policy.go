@@ -0,0 +1,156 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdHomeRealmDiscoveryPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_home_realm_discovery_policy",
+		Description: "Represents a home realm discovery policy, which controls the sign-in behavior for federated users, such as the auto-acceleration to a federated identity provider.",
+		Get: &plugin.GetConfig{
+			Hydrate: getAdHomeRealmDiscoveryPolicy,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAdHomeRealmDiscoveryPolicies,
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier for the policy.", Transform: transform.FromMethod("GetId")},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "Display name for this policy.", Transform: transform.FromMethod("GetDisplayName")},
+			{Name: "is_organization_default", Type: proto.ColumnType_BOOL, Description: "If set to true, activates this policy for the whole tenant. Only one home realm discovery policy can be the organization default.", Transform: transform.FromMethod("GetIsOrganizationDefault")},
+
+			// Other fields
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "Description for this policy.", Transform: transform.FromMethod("GetDescription")},
+
+			// JSON fields
+			{Name: "definition", Type: proto.ColumnType_JSON, Description: "The rules and settings of the policy, such as AccelerateToFederatedDomain, PreferredDomain or AllowCloudPasswordValidation.", Transform: transform.FromMethod("HomeRealmDiscoveryPolicyDefinition")},
+			{Name: "applies_to", Type: proto.ColumnType_JSON, Description: "The service principals the policy is assigned to.", Hydrate: getAdHomeRealmDiscoveryPolicyAppliesTo, Transform: transform.FromValue()},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adHomeRealmDiscoveryPolicyTitle)},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdHomeRealmDiscoveryPolicies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_home_realm_discovery_policy.listAdHomeRealmDiscoveryPolicies", "connection_error", err)
+		return nil, err
+	}
+
+	result, err := client.Policies().HomeRealmDiscoveryPolicies().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdHomeRealmDiscoveryPolicies", "list_home_realm_discovery_policy_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateHomeRealmDiscoveryPolicyCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.HomeRealmDiscoveryPolicyable) bool {
+		d.StreamListItem(ctx, &ADHomeRealmDiscoveryPolicyInfo{pageItem})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdHomeRealmDiscoveryPolicies", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAdHomeRealmDiscoveryPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	policyId := d.EqualsQuals["id"].GetStringValue()
+	if policyId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_home_realm_discovery_policy.getAdHomeRealmDiscoveryPolicy", "connection_error", err)
+		return nil, err
+	}
+
+	policy, err := client.Policies().HomeRealmDiscoveryPolicies().ByHomeRealmDiscoveryPolicyId(policyId).Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getAdHomeRealmDiscoveryPolicy", "get_home_realm_discovery_policy_error", errObj)
+		return nil, errObj
+	}
+
+	return &ADHomeRealmDiscoveryPolicyInfo{policy}, nil
+}
+
+func getAdHomeRealmDiscoveryPolicyAppliesTo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	policy := h.Item.(*ADHomeRealmDiscoveryPolicyInfo)
+	if policy.GetId() == nil {
+		return nil, nil
+	}
+
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_home_realm_discovery_policy.getAdHomeRealmDiscoveryPolicyAppliesTo", "connection_error", err)
+		return nil, err
+	}
+
+	result, err := client.Policies().HomeRealmDiscoveryPolicies().ByHomeRealmDiscoveryPolicyId(*policy.GetId()).AppliesTo().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getAdHomeRealmDiscoveryPolicyAppliesTo", "list_applies_to_error", errObj)
+		return nil, errObj
+	}
+
+	appliesTo := []map[string]interface{}{}
+	err = iteratePages(ctx, d, adapter, result, models.CreateDirectoryObjectCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.DirectoryObjectable) bool {
+		directoryObject := &ADDirectoryObjectInfo{pageItem}
+		appliesTo = append(appliesTo, map[string]interface{}{
+			"id":          directoryObject.GetId(),
+			"displayName": directoryObject.DirectoryObjectDisplayName(),
+			"type":        directoryObject.DirectoryObjectType(),
+		})
+		return true
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("getAdHomeRealmDiscoveryPolicyAppliesTo", "paging_error", err)
+		return nil, err
+	}
+
+	return appliesTo, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func adHomeRealmDiscoveryPolicyTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADHomeRealmDiscoveryPolicyInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	title := data.GetDisplayName()
+	if title == nil {
+		title = data.GetId()
+	}
+
+	return title, nil
+}
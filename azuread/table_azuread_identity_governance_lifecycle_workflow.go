@@ -0,0 +1,130 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models/identitygovernance"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// Lifecycle workflows require a Microsoft Entra ID Governance license, the tenants without it get an error about the missing license.
+// The permission errors are still returned.
+var lifecycleWorkflowIgnorableErrors = []string{"license"}
+
+//// TABLE DEFINITION
+
+func tableAzureAdIdentityGovernanceLifecycleWorkflow(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_identity_governance_lifecycle_workflow",
+		Description: "Represents a lifecycle workflow, which automates the tasks of the joiner, mover and leaver processes of the users.",
+		Get: &plugin.GetConfig{
+			Hydrate: getAdIdentityGovernanceLifecycleWorkflow,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate(append([]string{"Request_ResourceNotFound", "ResourceNotFound"}, lifecycleWorkflowIgnorableErrors...)),
+			},
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAdIdentityGovernanceLifecycleWorkflows,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate(lifecycleWorkflowIgnorableErrors),
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the workflow.", Transform: transform.FromMethod("GetId")},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name of the workflow.", Transform: transform.FromMethod("GetDisplayName")},
+			{Name: "category", Type: proto.ColumnType_STRING, Description: "The category of the workflow. Possible values are: joiner, mover, leaver.", Transform: transform.FromMethod("LifecycleWorkflowCategory")},
+			{Name: "is_enabled", Type: proto.ColumnType_BOOL, Description: "True if the workflow can run on demand or on schedule.", Transform: transform.FromMethod("GetIsEnabled")},
+			{Name: "created_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the workflow was created.", Transform: transform.FromMethod("GetCreatedDateTime")},
+
+			// Other fields
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the workflow.", Transform: transform.FromMethod("GetDescription")},
+			{Name: "is_scheduling_enabled", Type: proto.ColumnType_BOOL, Description: "True if the workflow runs on schedule. Requires is_enabled to be true.", Transform: transform.FromMethod("GetIsSchedulingEnabled")},
+			{Name: "last_modified_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the workflow was last modified.", Transform: transform.FromMethod("GetLastModifiedDateTime")},
+			{Name: "next_schedule_run_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time of the next scheduled run of the workflow.", Transform: transform.FromMethod("GetNextScheduleRunDateTime")},
+			{Name: "version", Type: proto.ColumnType_INT, Description: "The current version of the workflow, incremented each time the workflow is updated.", Transform: transform.FromMethod("GetVersion")},
+
+			// JSON fields
+			{Name: "execution_conditions", Type: proto.ColumnType_JSON, Description: "The conditions of the execution of the workflow, i.e. the users in scope and the trigger, such as a number of days before or after the employee hire date.", Transform: transform.FromMethod("LifecycleWorkflowExecutionConditions")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adIdentityGovernanceLifecycleWorkflowTitle)},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdIdentityGovernanceLifecycleWorkflows(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_identity_governance_lifecycle_workflow.listAdIdentityGovernanceLifecycleWorkflows", "connection_error", err)
+		return nil, err
+	}
+
+	result, err := client.IdentityGovernance().LifecycleWorkflows().Workflows().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdIdentityGovernanceLifecycleWorkflows", "list_lifecycle_workflow_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, identitygovernance.CreateWorkflowCollectionResponseFromDiscriminatorValue, nil, func(pageItem identitygovernance.Workflowable) bool {
+		d.StreamListItem(ctx, &ADLifecycleWorkflowInfo{pageItem})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdIdentityGovernanceLifecycleWorkflows", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAdIdentityGovernanceLifecycleWorkflow(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	workflowId := d.EqualsQuals["id"].GetStringValue()
+	if workflowId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_identity_governance_lifecycle_workflow.getAdIdentityGovernanceLifecycleWorkflow", "connection_error", err)
+		return nil, err
+	}
+
+	workflow, err := client.IdentityGovernance().LifecycleWorkflows().Workflows().ByWorkflowId(workflowId).Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getAdIdentityGovernanceLifecycleWorkflow", "get_lifecycle_workflow_error", errObj)
+		return nil, errObj
+	}
+
+	return &ADLifecycleWorkflowInfo{workflow}, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func adIdentityGovernanceLifecycleWorkflowTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADLifecycleWorkflowInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	title := data.GetDisplayName()
+	if title == nil {
+		title = data.GetId()
+	}
+
+	return title, nil
+}
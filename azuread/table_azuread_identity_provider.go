@@ -9,7 +9,6 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
-	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
 	"github.com/microsoftgraph/msgraph-sdk-go/identity"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 
@@ -40,7 +39,7 @@ func tableAzureAdIdentityProvider(_ context.Context) *plugin.Table {
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "The display name of the identity provider.", Transform: transform.FromMethod("GetDisplayName")},
 
 			// Other fields
-			{Name: "type", Type: proto.ColumnType_STRING, Description: "The identity provider type is a required field. For B2B scenario: Google, Facebook. For B2C scenario: Microsoft, Google, Amazon, LinkedIn, Facebook, GitHub, Twitter, Weibo, QQ, WeChat, OpenIDConnect.", Transform: transform.FromMethod("GetIdentityProviderType")},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The identity provider type is a required field. For B2B scenario: Google, Facebook. For B2C scenario: Microsoft, Google, Amazon, LinkedIn, Facebook, GitHub, Twitter, Weibo, QQ, WeChat, OpenIDConnect.", Transform: transform.FromField("IdentityProviderType")},
 			{Name: "client_id", Type: proto.ColumnType_STRING, Description: "The client ID for the application. This is the client ID obtained when registering the application with the identity provider."},
 			{Name: "client_secret", Type: proto.ColumnType_STRING, Description: "The client secret for the application. This is the client secret obtained when registering the application with the identity provider. This is write-only. A read operation will return ****."},
 			{Name: "filter", Type: proto.ColumnType_STRING, Transform: transform.FromQual("filter"), Description: "Odata query to search for resources."},
@@ -98,17 +97,24 @@ func listAdIdentityProviders(ctx context.Context, d *plugin.QueryData, _ *plugin
 		return nil, errObj
 	}
 
-	pageIterator, err := msgraphcore.NewPageIterator[models.BuiltInIdentityProvider](result, adapter, models.CreateBuiltInIdentityProviderFromDiscriminatorValue)
-	if err != nil {
-		plugin.Logger(ctx).Error("listAdIdentityProviders", "create_iterator_instance_error", err)
-		return nil, err
-	}
+	err = iteratePages(ctx, d, adapter, result, models.CreateIdentityProviderBaseCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.IdentityProviderBaseable) bool {
+		info := &ADIdentityProviderInfo{
+			IdentityProviderBaseable: pageItem,
+			ClientId:                 pageItem.GetAdditionalData()["clientId"],
+			ClientSecret:             pageItem.GetAdditionalData()["clientSecret"],
+		}
 
-	err = pageIterator.Iterate(ctx, func(pageItem models.BuiltInIdentityProvider) bool {
-		clientID := pageItem.GetAdditionalData()["clientId"]
-		clientSecret := pageItem.GetAdditionalData()["clientSecret"]
+		// The providers are returned as their derived types, which carry the type and the client credentials as properties
+		switch provider := pageItem.(type) {
+		case models.BuiltInIdentityProviderable:
+			info.IdentityProviderType = provider.GetIdentityProviderType()
+		case models.SocialIdentityProviderable:
+			info.IdentityProviderType = provider.GetIdentityProviderType()
+			info.ClientId = provider.GetClientId()
+			info.ClientSecret = provider.GetClientSecret()
+		}
 
-		d.StreamListItem(ctx, &ADIdentityProviderInfo{pageItem, clientID, clientSecret})
+		d.StreamListItem(ctx, info)
 
 		// Context can be cancelled due to manual cancellation or the limit has been hit
 		return d.RowsRemaining(ctx) != 0
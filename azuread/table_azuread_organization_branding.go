@@ -0,0 +1,82 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdOrganizationBranding(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_organization_branding",
+		Description: "Represents the company branding of the sign-in pages of the tenant, for each locale.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdOrganizationBrandings,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				// Tenants without custom branding have no branding resource
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "ResourceNotFound"}),
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the organization (tenant).", Transform: transform.FromField("OrganizationId")},
+			{Name: "locale", Type: proto.ColumnType_STRING, Description: "The locale of the branding, e.g. fr-FR. The default branding, which applies when no branding exists for the locale of the user, has the locale 0.", Transform: transform.FromMethod("GetId")},
+			{Name: "sign_in_page_text", Type: proto.ColumnType_STRING, Description: "The text that appears at the bottom of the sign-in box.", Transform: transform.FromMethod("GetSignInPageText")},
+			{Name: "username_hint_text", Type: proto.ColumnType_STRING, Description: "The string that shows as the hint in the username textbox on the sign-in screen.", Transform: transform.FromMethod("GetUsernameHintText")},
+			{Name: "background_color", Type: proto.ColumnType_STRING, Description: "The color that appears in place of the background image in low-bandwidth connections.", Transform: transform.FromMethod("GetBackgroundColor")},
+
+			// Other fields
+			{Name: "has_background_image", Type: proto.ColumnType_BOOL, Description: "True if a background image is configured for the sign-in page.", Transform: transform.FromMethod("OrganizationBrandingHasBackgroundImage")},
+			{Name: "has_banner_logo", Type: proto.ColumnType_BOOL, Description: "True if a banner logo is configured for the sign-in page.", Transform: transform.FromMethod("OrganizationBrandingHasBannerLogo")},
+			{Name: "has_square_logo", Type: proto.ColumnType_BOOL, Description: "True if a square logo is configured for the sign-in page.", Transform: transform.FromMethod("OrganizationBrandingHasSquareLogo")},
+			{Name: "cdn_list", Type: proto.ColumnType_JSON, Description: "A list of base URLs for all available CDN providers that are serving the assets of the branding.", Transform: transform.FromMethod("GetCdnList")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetId")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdOrganizationBrandings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	tenantId, err := getTenant(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_organization_branding.listAdOrganizationBrandings", "get_tenant_error", err)
+		return nil, err
+	}
+	organizationId := tenantId.(string)
+
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_organization_branding.listAdOrganizationBrandings", "connection_error", err)
+		return nil, err
+	}
+
+	result, err := client.Organization().ByOrganizationId(organizationId).Branding().Localizations().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdOrganizationBrandings", "list_branding_localization_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateOrganizationalBrandingLocalizationCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.OrganizationalBrandingLocalizationable) bool {
+		d.StreamListItem(ctx, &ADOrganizationBrandingInfo{pageItem, &organizationId})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdOrganizationBrandings", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
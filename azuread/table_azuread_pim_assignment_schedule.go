@@ -0,0 +1,112 @@
+package azuread
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/rolemanagement"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdPimAssignmentSchedule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_pim_assignment_schedule",
+		Description: "Represents the schedule of a directory role a principal holds through Privileged Identity Management (PIM), either assigned by an administrator or activated from an eligibility.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdPimAssignmentSchedules,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate(pimIgnorableErrors),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "principal_id", Require: plugin.Optional},
+				{Name: "role_definition_id", Require: plugin.Optional},
+				{Name: "directory_scope_id", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the assignment schedule.", Transform: transform.FromMethod("GetId")},
+			{Name: "principal_id", Type: proto.ColumnType_STRING, Description: "The identifier of the user, group or service principal the role is assigned to.", Transform: transform.FromMethod("GetPrincipalId")},
+			{Name: "role_definition_id", Type: proto.ColumnType_STRING, Description: "The identifier of the role definition, which is the template ID for the built-in roles.", Transform: transform.FromMethod("GetRoleDefinitionId")},
+			{Name: "role_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the role definition.", Transform: transform.FromMethod("PimAssignmentScheduleRoleDisplayName")},
+			{Name: "directory_scope_id", Type: proto.ColumnType_STRING, Description: "The identifier of the directory object representing the scope of the assignment, / for the whole tenant.", Transform: transform.FromMethod("GetDirectoryScopeId")},
+			{Name: "assignment_type", Type: proto.ColumnType_STRING, Description: "How the role was assigned. Possible values are: Assigned, by an administrator, and Activated, from an eligibility.", Transform: transform.FromMethod("GetAssignmentType")},
+			{Name: "member_type", Type: proto.ColumnType_STRING, Description: "How the principal holds the role. Possible values are: Direct, Group, for a member of an assigned group, and Inherited.", Transform: transform.FromMethod("GetMemberType")},
+			{Name: "start_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the assignment starts.", Transform: transform.FromMethod("PimAssignmentScheduleStartDateTime")},
+			{Name: "end_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the assignment expires. Null for a permanent assignment.", Transform: transform.FromMethod("PimAssignmentScheduleEndDateTime")},
+
+			// Other fields
+			{Name: "app_scope_id", Type: proto.ColumnType_STRING, Description: "The identifier of the app specific scope of the assignment, when the scope is defined by the application.", Transform: transform.FromMethod("GetAppScopeId")},
+			{Name: "scope_type", Type: proto.ColumnType_STRING, Description: "Where the role applies. Possible values are: tenant, administrativeUnit, for an administrative unit, directoryObject, for a single object such as an application, and application, for an app specific scope.", Transform: transform.FromMethod("PimAssignmentScheduleScopeType")},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the assignment schedule, e.g. Provisioned.", Transform: transform.FromMethod("GetStatus")},
+			{Name: "eligibility_schedule_id", Type: proto.ColumnType_STRING, Description: "The identifier of the eligibility schedule the role was activated from. Null for an assigned role.", Transform: transform.FromMethod("PimAssignmentScheduleEligibilityScheduleId")},
+			{Name: "created_using", Type: proto.ColumnType_STRING, Description: "The identifier of the request which created the assignment schedule.", Transform: transform.FromMethod("GetCreatedUsing")},
+			{Name: "created_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the assignment schedule was created.", Transform: transform.FromMethod("GetCreatedDateTime")},
+			{Name: "modified_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the assignment schedule was last modified.", Transform: transform.FromMethod("GetModifiedDateTime")},
+
+			// JSON fields
+			{Name: "schedule_info", Type: proto.ColumnType_JSON, Description: "The period of the assignment, with its start date and time and its expiration, which can be a duration, an end date and time, or none for a permanent assignment.", Transform: transform.FromMethod("PimAssignmentScheduleScheduleInfo")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetId")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdPimAssignmentSchedules(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_pim_assignment_schedule.listAdPimAssignmentSchedules", "connection_error", err)
+		return nil, err
+	}
+
+	input := &rolemanagement.DirectoryRoleAssignmentSchedulesRequestBuilderGetQueryParameters{
+		Expand: []string{"roleDefinition($select=displayName)", "activatedUsing($select=id)"},
+	}
+
+	var filter []string
+	for qual, property := range pimScheduleFilterQuals {
+		if d.EqualsQuals[qual] != nil {
+			filter = append(filter, fmt.Sprintf("%s eq '%s'", property, escapeODataString(d.EqualsQuals[qual].GetStringValue())))
+		}
+	}
+
+	if len(filter) > 0 {
+		joinStr := strings.Join(filter, " and ")
+		input.Filter = &joinStr
+	}
+
+	options := &rolemanagement.DirectoryRoleAssignmentSchedulesRequestBuilderGetRequestConfiguration{
+		QueryParameters: input,
+	}
+
+	result, err := client.RoleManagement().Directory().RoleAssignmentSchedules().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdPimAssignmentSchedules", "list_role_assignment_schedule_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateUnifiedRoleAssignmentScheduleCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.UnifiedRoleAssignmentScheduleable) bool {
+		d.StreamListItem(ctx, &ADPimAssignmentScheduleInfo{pageItem})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdPimAssignmentSchedules", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
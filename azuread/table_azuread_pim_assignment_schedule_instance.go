@@ -0,0 +1,107 @@
+package azuread
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/rolemanagement"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdPimAssignmentScheduleInstance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_pim_assignment_schedule_instance",
+		Description: "Represents a directory role a principal holds right now through Privileged Identity Management (PIM), either assigned by an administrator or activated from an eligibility.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdPimAssignmentScheduleInstances,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate(pimIgnorableErrors),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "principal_id", Require: plugin.Optional},
+				{Name: "role_definition_id", Require: plugin.Optional},
+				{Name: "directory_scope_id", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the assignment instance.", Transform: transform.FromMethod("GetId")},
+			{Name: "principal_id", Type: proto.ColumnType_STRING, Description: "The identifier of the user, group or service principal the role is assigned to.", Transform: transform.FromMethod("GetPrincipalId")},
+			{Name: "role_definition_id", Type: proto.ColumnType_STRING, Description: "The identifier of the role definition, which is the template ID for the built-in roles.", Transform: transform.FromMethod("GetRoleDefinitionId")},
+			{Name: "role_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the role definition.", Transform: transform.FromMethod("PimAssignmentScheduleInstanceRoleDisplayName")},
+			{Name: "directory_scope_id", Type: proto.ColumnType_STRING, Description: "The identifier of the directory object representing the scope of the assignment, / for the whole tenant.", Transform: transform.FromMethod("GetDirectoryScopeId")},
+			{Name: "assignment_type", Type: proto.ColumnType_STRING, Description: "How the role was assigned. Possible values are: Assigned, by an administrator, and Activated, from an eligibility.", Transform: transform.FromMethod("GetAssignmentType")},
+			{Name: "member_type", Type: proto.ColumnType_STRING, Description: "How the principal holds the role. Possible values are: Direct, Group, for a member of an assigned group, and Inherited.", Transform: transform.FromMethod("GetMemberType")},
+			{Name: "start_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the role assignment or activation started.", Transform: transform.FromMethod("GetStartDateTime")},
+			{Name: "end_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the role assignment or activation expires. Null for a permanent assignment.", Transform: transform.FromMethod("GetEndDateTime")},
+
+			// Other fields
+			{Name: "app_scope_id", Type: proto.ColumnType_STRING, Description: "The identifier of the app specific scope of the assignment, when the scope is defined by the application.", Transform: transform.FromMethod("GetAppScopeId")},
+			{Name: "scope_type", Type: proto.ColumnType_STRING, Description: "Where the role applies. Possible values are: tenant, administrativeUnit, for an administrative unit, directoryObject, for a single object such as an application, and application, for an app specific scope.", Transform: transform.FromMethod("PimAssignmentScheduleInstanceScopeType")},
+			{Name: "eligibility_schedule_instance_id", Type: proto.ColumnType_STRING, Description: "The identifier of the eligibility instance the role was activated from. Null for an assigned role.", Transform: transform.FromMethod("PimAssignmentScheduleInstanceEligibilityScheduleInstanceId")},
+			{Name: "role_assignment_schedule_id", Type: proto.ColumnType_STRING, Description: "The identifier of the assignment schedule the instance was created from.", Transform: transform.FromMethod("GetRoleAssignmentScheduleId")},
+			{Name: "role_assignment_origin_id", Type: proto.ColumnType_STRING, Description: "The identifier of the role assignment in Azure AD the instance corresponds to.", Transform: transform.FromMethod("GetRoleAssignmentOriginId")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetId")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdPimAssignmentScheduleInstances(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_pim_assignment_schedule_instance.listAdPimAssignmentScheduleInstances", "connection_error", err)
+		return nil, err
+	}
+
+	input := &rolemanagement.DirectoryRoleAssignmentScheduleInstancesRequestBuilderGetQueryParameters{
+		Expand: []string{"roleDefinition($select=displayName)", "activatedUsing($select=id)"},
+	}
+
+	var filter []string
+	for qual, property := range pimScheduleFilterQuals {
+		if d.EqualsQuals[qual] != nil {
+			filter = append(filter, fmt.Sprintf("%s eq '%s'", property, escapeODataString(d.EqualsQuals[qual].GetStringValue())))
+		}
+	}
+
+	if len(filter) > 0 {
+		joinStr := strings.Join(filter, " and ")
+		input.Filter = &joinStr
+	}
+
+	options := &rolemanagement.DirectoryRoleAssignmentScheduleInstancesRequestBuilderGetRequestConfiguration{
+		QueryParameters: input,
+	}
+
+	result, err := client.RoleManagement().Directory().RoleAssignmentScheduleInstances().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdPimAssignmentScheduleInstances", "list_role_assignment_schedule_instance_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateUnifiedRoleAssignmentScheduleInstanceCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.UnifiedRoleAssignmentScheduleInstanceable) bool {
+		d.StreamListItem(ctx, &ADPimAssignmentScheduleInstanceInfo{pageItem})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdPimAssignmentScheduleInstances", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
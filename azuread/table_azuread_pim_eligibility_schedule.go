@@ -0,0 +1,121 @@
+package azuread
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/rolemanagement"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// PIM requires an Azure AD Premium P2 license, there are no schedules in tenants without it.
+// A missing permission is returned, so it isn't mistaken for a tenant without eligible principals.
+var pimIgnorableErrors = []string{"AadPremiumLicenseRequired"}
+
+// The PIM schedules can be filtered on the principal, the role and the scope
+var pimScheduleFilterQuals = map[string]string{
+	"principal_id":       "principalId",
+	"role_definition_id": "roleDefinitionId",
+	"directory_scope_id": "directoryScopeId",
+}
+
+//// TABLE DEFINITION
+
+func tableAzureAdPimEligibilitySchedule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_pim_eligibility_schedule",
+		Description: "Represents the schedule of a directory role a principal is eligible to activate through Privileged Identity Management (PIM).",
+		List: &plugin.ListConfig{
+			Hydrate: listAdPimEligibilitySchedules,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate(pimIgnorableErrors),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "principal_id", Require: plugin.Optional},
+				{Name: "role_definition_id", Require: plugin.Optional},
+				{Name: "directory_scope_id", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the eligibility schedule.", Transform: transform.FromMethod("GetId")},
+			{Name: "principal_id", Type: proto.ColumnType_STRING, Description: "The identifier of the user, group or service principal eligible to the role.", Transform: transform.FromMethod("GetPrincipalId")},
+			{Name: "role_definition_id", Type: proto.ColumnType_STRING, Description: "The identifier of the role definition, which is the template ID for the built-in roles.", Transform: transform.FromMethod("GetRoleDefinitionId")},
+			{Name: "role_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the role definition.", Transform: transform.FromMethod("PimEligibilityScheduleRoleDisplayName")},
+			{Name: "directory_scope_id", Type: proto.ColumnType_STRING, Description: "The identifier of the directory object representing the scope of the eligibility, / for the whole tenant.", Transform: transform.FromMethod("GetDirectoryScopeId")},
+			{Name: "member_type", Type: proto.ColumnType_STRING, Description: "How the principal is eligible to the role. Possible values are: Direct, Group, for a member of an eligible group, and Inherited.", Transform: transform.FromMethod("GetMemberType")},
+			{Name: "start_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the eligibility starts.", Transform: transform.FromMethod("PimEligibilityScheduleStartDateTime")},
+			{Name: "end_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the eligibility expires. Null for a permanent eligibility.", Transform: transform.FromMethod("PimEligibilityScheduleEndDateTime")},
+
+			// Other fields
+			{Name: "app_scope_id", Type: proto.ColumnType_STRING, Description: "The identifier of the app specific scope of the eligibility, when the scope is defined by the application.", Transform: transform.FromMethod("GetAppScopeId")},
+			{Name: "scope_type", Type: proto.ColumnType_STRING, Description: "Where the role applies. Possible values are: tenant, administrativeUnit, for an administrative unit, directoryObject, for a single object such as an application, and application, for an app specific scope.", Transform: transform.FromMethod("PimEligibilityScheduleScopeType")},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the eligibility schedule, e.g. Provisioned.", Transform: transform.FromMethod("GetStatus")},
+			{Name: "created_using", Type: proto.ColumnType_STRING, Description: "The identifier of the request which created the eligibility schedule.", Transform: transform.FromMethod("GetCreatedUsing")},
+			{Name: "created_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the eligibility schedule was created.", Transform: transform.FromMethod("GetCreatedDateTime")},
+			{Name: "modified_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the eligibility schedule was last modified.", Transform: transform.FromMethod("GetModifiedDateTime")},
+
+			// JSON fields
+			{Name: "schedule_info", Type: proto.ColumnType_JSON, Description: "The period of the eligibility, with its start date and time and its expiration, which can be a duration, an end date and time, or none for a permanent eligibility.", Transform: transform.FromMethod("PimEligibilityScheduleScheduleInfo")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetId")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdPimEligibilitySchedules(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_pim_eligibility_schedule.listAdPimEligibilitySchedules", "connection_error", err)
+		return nil, err
+	}
+
+	input := &rolemanagement.DirectoryRoleEligibilitySchedulesRequestBuilderGetQueryParameters{
+		Expand: []string{"roleDefinition($select=displayName)"},
+	}
+
+	var filter []string
+	for qual, property := range pimScheduleFilterQuals {
+		if d.EqualsQuals[qual] != nil {
+			filter = append(filter, fmt.Sprintf("%s eq '%s'", property, escapeODataString(d.EqualsQuals[qual].GetStringValue())))
+		}
+	}
+
+	if len(filter) > 0 {
+		joinStr := strings.Join(filter, " and ")
+		input.Filter = &joinStr
+	}
+
+	options := &rolemanagement.DirectoryRoleEligibilitySchedulesRequestBuilderGetRequestConfiguration{
+		QueryParameters: input,
+	}
+
+	result, err := client.RoleManagement().Directory().RoleEligibilitySchedules().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdPimEligibilitySchedules", "list_role_eligibility_schedule_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateUnifiedRoleEligibilityScheduleCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.UnifiedRoleEligibilityScheduleable) bool {
+		d.StreamListItem(ctx, &ADPimEligibilityScheduleInfo{pageItem})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdPimEligibilitySchedules", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
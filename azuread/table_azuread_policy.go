@@ -0,0 +1,207 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoft/kiota-abstractions-go/serialization"
+	msgraphsdkgo "github.com/microsoftgraph/msgraph-sdk-go"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_policy",
+		Description: "Represents the policy objects configured in the Azure Active Directory tenant.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdPolicies,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "policy_type", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "policy_type", Type: proto.ColumnType_STRING, Description: "The type of the policy. Possible values are: activityBasedTimeoutPolicy, authorizationPolicy, claimsMappingPolicy, homeRealmDiscoveryPolicy, identitySecurityDefaultsEnforcementPolicy, tokenIssuancePolicy, tokenLifetimePolicy."},
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier for the policy."},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "Display name for this policy."},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "Description for this policy."},
+			{Name: "is_organization_default", Type: proto.ColumnType_BOOL, Description: "If set to true, activates this policy. There can be many policies for the same policy type, but only one can be activated as the organization default. Only applicable to activity based timeout, claims mapping, home realm discovery, token issuance and token lifetime policies."},
+
+			// JSON fields
+			{Name: "definition", Type: proto.ColumnType_JSON, Description: "A string collection containing a JSON string that defines the rules and settings for the policy. Only applicable to activity based timeout, claims mapping, home realm discovery, token issuance and token lifetime policies."},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adPolicyTitle)},
+		}),
+	}
+}
+
+// adPolicyLister returns the policies of a single policy type
+type adPolicyLister func(ctx context.Context, d *plugin.QueryData, client *msgraphsdkgo.GraphServiceClient, adapter *msgraphsdkgo.GraphRequestAdapter) ([]*ADPolicyInfo, error)
+
+var adPolicyListers = []struct {
+	PolicyType string
+	List       adPolicyLister
+}{
+	{"activityBasedTimeoutPolicy", listAdActivityBasedTimeoutPolicies},
+	{"authorizationPolicy", listAdPolicyAuthorizationPolicy},
+	{"claimsMappingPolicy", listAdClaimsMappingPolicies},
+	{"homeRealmDiscoveryPolicy", listAdHomeRealmDiscoveryPolicies},
+	{"identitySecurityDefaultsEnforcementPolicy", listAdPolicyIdentitySecurityDefaultsEnforcementPolicy},
+	{"tokenIssuancePolicy", listAdTokenIssuancePolicies},
+	{"tokenLifetimePolicy", listAdTokenLifetimePolicies},
+}
+
+//// LIST FUNCTION
+
+func listAdPolicies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_policy.listAdPolicies", "connection_error", err)
+		return nil, err
+	}
+
+	policyType := d.EqualsQuals["policy_type"].GetStringValue()
+
+	for _, lister := range adPolicyListers {
+		if policyType != "" && policyType != lister.PolicyType {
+			continue
+		}
+
+		policies, err := lister.List(ctx, d, client, adapter)
+		if err != nil {
+			errObj := getErrorObject(err)
+			plugin.Logger(ctx).Error("listAdPolicies", "list_policy_error", errObj, "policy_type", lister.PolicyType)
+			return nil, errObj
+		}
+
+		for _, policy := range policies {
+			policy.PolicyType = lister.PolicyType
+			d.StreamListItem(ctx, policy)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func listAdActivityBasedTimeoutPolicies(ctx context.Context, d *plugin.QueryData, client *msgraphsdkgo.GraphServiceClient, adapter *msgraphsdkgo.GraphRequestAdapter) ([]*ADPolicyInfo, error) {
+	result, err := client.Policies().ActivityBasedTimeoutPolicies().Get(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return readStsPolicyPages(ctx, d, adapter, result, models.CreateActivityBasedTimeoutPolicyCollectionResponseFromDiscriminatorValue)
+}
+
+func listAdClaimsMappingPolicies(ctx context.Context, d *plugin.QueryData, client *msgraphsdkgo.GraphServiceClient, adapter *msgraphsdkgo.GraphRequestAdapter) ([]*ADPolicyInfo, error) {
+	result, err := client.Policies().ClaimsMappingPolicies().Get(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return readStsPolicyPages(ctx, d, adapter, result, models.CreateClaimsMappingPolicyCollectionResponseFromDiscriminatorValue)
+}
+
+func listAdHomeRealmDiscoveryPolicies(ctx context.Context, d *plugin.QueryData, client *msgraphsdkgo.GraphServiceClient, adapter *msgraphsdkgo.GraphRequestAdapter) ([]*ADPolicyInfo, error) {
+	result, err := client.Policies().HomeRealmDiscoveryPolicies().Get(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return readStsPolicyPages(ctx, d, adapter, result, models.CreateHomeRealmDiscoveryPolicyCollectionResponseFromDiscriminatorValue)
+}
+
+func listAdTokenIssuancePolicies(ctx context.Context, d *plugin.QueryData, client *msgraphsdkgo.GraphServiceClient, adapter *msgraphsdkgo.GraphRequestAdapter) ([]*ADPolicyInfo, error) {
+	result, err := client.Policies().TokenIssuancePolicies().Get(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return readStsPolicyPages(ctx, d, adapter, result, models.CreateTokenIssuancePolicyCollectionResponseFromDiscriminatorValue)
+}
+
+func listAdTokenLifetimePolicies(ctx context.Context, d *plugin.QueryData, client *msgraphsdkgo.GraphServiceClient, adapter *msgraphsdkgo.GraphRequestAdapter) ([]*ADPolicyInfo, error) {
+	result, err := client.Policies().TokenLifetimePolicies().Get(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return readStsPolicyPages(ctx, d, adapter, result, models.CreateTokenLifetimePolicyCollectionResponseFromDiscriminatorValue)
+}
+
+func listAdPolicyAuthorizationPolicy(ctx context.Context, _ *plugin.QueryData, client *msgraphsdkgo.GraphServiceClient, _ *msgraphsdkgo.GraphRequestAdapter) ([]*ADPolicyInfo, error) {
+	result, err := client.Policies().AuthorizationPolicy().Get(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*ADPolicyInfo{{
+		Id:          result.GetId(),
+		DisplayName: result.GetDisplayName(),
+		Description: result.GetDescription(),
+	}}, nil
+}
+
+func listAdPolicyIdentitySecurityDefaultsEnforcementPolicy(ctx context.Context, _ *plugin.QueryData, client *msgraphsdkgo.GraphServiceClient, _ *msgraphsdkgo.GraphRequestAdapter) ([]*ADPolicyInfo, error) {
+	result, err := client.Policies().IdentitySecurityDefaultsEnforcementPolicy().Get(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*ADPolicyInfo{{
+		Id:          result.GetId(),
+		DisplayName: result.GetDisplayName(),
+		Description: result.GetDescription(),
+	}}, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// readStsPolicyPages reads the policies of all the pages of a collection of STS policies, e.g. the token lifetime policies
+func readStsPolicyPages(ctx context.Context, d *plugin.QueryData, adapter *msgraphsdkgo.GraphRequestAdapter, result serialization.Parsable, constructorFunc serialization.ParsableFactory) ([]*ADPolicyInfo, error) {
+	policies := []*ADPolicyInfo{}
+	err := iteratePages(ctx, d, adapter, result, constructorFunc, nil, func(policy models.StsPolicyable) bool {
+		policies = append(policies, stsPolicyToADPolicyInfo(policy))
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+func stsPolicyToADPolicyInfo(policy models.StsPolicyable) *ADPolicyInfo {
+	return &ADPolicyInfo{
+		Id:                    policy.GetId(),
+		DisplayName:           policy.GetDisplayName(),
+		Description:           policy.GetDescription(),
+		IsOrganizationDefault: policy.GetIsOrganizationDefault(),
+		Definition:            policy.GetDefinition(),
+	}
+}
+
+func adPolicyTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADPolicyInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	title := data.DisplayName
+	if title == nil {
+		title = data.Id
+	}
+
+	return title, nil
+}
@@ -0,0 +1,106 @@
+package azuread
+
+import (
+	"context"
+	"strings"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdPrincipalAppRoleAssignment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_principal_app_role_assignment",
+		Description: "Represents an application role assigned to a principal, whether a user, a group or a service principal.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdPrincipalAppRoleAssignments,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "principal_id", Require: plugin.Required},
+				{Name: "principal_type", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "principal_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the user, group or service principal granted the app role.", Transform: transform.FromQual("principal_id")},
+			{Name: "principal_type", Type: proto.ColumnType_STRING, Description: "The type of the principal granted the app role. Possible values are: User, Group, ServicePrincipal.", Transform: transform.FromMethod("GetPrincipalType")},
+			{Name: "app_role_id", Type: proto.ColumnType_STRING, Description: "The identifier of the app role assigned to the principal. 00000000-0000-0000-0000-000000000000 when the principal is assigned to the resource application without any specific app role.", Transform: transform.FromMethod("GetAppRoleId")},
+			{Name: "resource_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the service principal of the resource application.", Transform: transform.FromMethod("GetResourceId")},
+			{Name: "resource_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the service principal of the resource application.", Transform: transform.FromMethod("GetResourceDisplayName")},
+			{Name: "created_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the app role assignment was created.", Transform: transform.FromMethod("GetCreatedDateTime")},
+
+			// Other fields
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the app role assignment.", Transform: transform.FromMethod("GetId")},
+			{Name: "principal_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the principal granted the app role.", Transform: transform.FromMethod("GetPrincipalDisplayName")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetResourceDisplayName")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdPrincipalAppRoleAssignments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	principalId := d.EqualsQuals["principal_id"].GetStringValue()
+	if principalId == "" {
+		return nil, nil
+	}
+
+	// The app role assignments are read from the endpoint of the principal type, which is resolved when not given
+	principalType := ""
+	if d.EqualsQuals["principal_type"] != nil {
+		principalType = d.EqualsQuals["principal_type"].GetStringValue()
+	} else {
+		principal, err := resolveDirectoryObjectName(ctx, d, principalId)
+		if err != nil {
+			return nil, err
+		}
+		principalType = principal.ObjectType
+	}
+
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_principal_app_role_assignment.listAdPrincipalAppRoleAssignments", "connection_error", err)
+		return nil, err
+	}
+
+	var result models.AppRoleAssignmentCollectionResponseable
+	switch strings.ToLower(principalType) {
+	case "user":
+		result, err = client.Users().ByUserId(principalId).AppRoleAssignments().Get(ctx, nil)
+	case "group":
+		result, err = client.Groups().ByGroupId(principalId).AppRoleAssignments().Get(ctx, nil)
+	case "serviceprincipal":
+		result, err = client.ServicePrincipals().ByServicePrincipalId(principalId).AppRoleAssignments().Get(ctx, nil)
+	default:
+		// Other principal types, e.g. devices, can't be assigned app roles
+		return nil, nil
+	}
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdPrincipalAppRoleAssignments", "list_app_role_assignment_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateAppRoleAssignmentCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.AppRoleAssignmentable) bool {
+		d.StreamListItem(ctx, &ADAppRoleAssignmentInfo{pageItem})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdPrincipalAppRoleAssignments", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,135 @@
+package azuread
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/auditlogs"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdProvisioningLog(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_provisioning_log",
+		Description: "Represents an action performed by the Azure AD provisioning service, such as the creation or update of a user in a SCIM provisioned application.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdProvisioningLogs,
+			KeyColumns: plugin.KeyColumnSlice{
+				// Key fields
+				{Name: "activity_date_time", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
+				{Name: "service_principal_id", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Indicates the unique ID for the activity.", Transform: transform.FromMethod("GetId")},
+			{Name: "activity_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the activity was performed, in UTC.", Transform: transform.FromMethod("GetActivityDateTime")},
+			{Name: "provisioning_action", Type: proto.ColumnType_STRING, Description: "Indicates the activity name or the operation name. Possible values are: create, update, delete, disable, other, staged.", Transform: transform.FromMethod("ProvisioningObjectSummaryProvisioningAction")},
+			{Name: "change_id", Type: proto.ColumnType_STRING, Description: "Unique ID of this change in this cycle.", Transform: transform.FromMethod("GetChangeId")},
+			{Name: "service_principal_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the service principal of the application being provisioned.", Transform: transform.FromMethod("ProvisioningObjectSummaryServicePrincipalId")},
+
+			// Other fields
+			{Name: "cycle_id", Type: proto.ColumnType_STRING, Description: "Unique ID per job iteration.", Transform: transform.FromMethod("GetCycleId")},
+			{Name: "job_id", Type: proto.ColumnType_STRING, Description: "The unique ID for the whole provisioning job.", Transform: transform.FromMethod("GetJobId")},
+
+			// JSON fields
+			{Name: "source_system", Type: proto.ColumnType_JSON, Description: "Details of the system that the object is provisioned from.", Transform: transform.FromMethod("ProvisioningObjectSummarySourceSystem")},
+			{Name: "target_system", Type: proto.ColumnType_JSON, Description: "Details of the system that the object is provisioned to.", Transform: transform.FromMethod("ProvisioningObjectSummaryTargetSystem")},
+			{Name: "status", Type: proto.ColumnType_JSON, Description: "Details of the provisioning status, including the error code, the reason and the recommended action of a failed provisioning.", Transform: transform.FromMethod("ProvisioningObjectSummaryStatus")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetId")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdProvisioningLogs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_provisioning_log.listAdProvisioningLogs", "connection_error", err)
+		return nil, err
+	}
+
+	// List operations
+	input := &auditlogs.ProvisioningRequestBuilderGetQueryParameters{
+		Top: Int32(999),
+	}
+
+	// Restrict the limit value to be passed in the query parameter which is not between 1 and 999, otherwise API will throw an error as follow
+	// unexpected status 400 with OData error: Request_UnsupportedQuery: Invalid page size specified: '1000'. Must be between 1 and 999 inclusive.
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 999 {
+			l := int32(*limit)
+			input.Top = Int32(l)
+		}
+	}
+
+	var filter []string
+
+	if d.EqualsQuals["service_principal_id"] != nil {
+		filter = append(filter, fmt.Sprintf("servicePrincipal/id eq '%s'", escapeODataString(d.EqualsQuals["service_principal_id"].GetStringValue())))
+	}
+
+	// Filter by activityDateTime
+	if d.Quals["activity_date_time"] != nil {
+		for _, q := range d.Quals["activity_date_time"].Quals {
+			givenTime := q.Value.GetTimestampValue().AsTime()
+
+			switch q.Operator {
+			case ">":
+				startTime := givenTime.Add(time.Second * 1).Format(time.RFC3339)
+				filter = append(filter, fmt.Sprintf("activityDateTime ge %s", startTime))
+			case ">=":
+				filter = append(filter, fmt.Sprintf("activityDateTime ge %s", givenTime.Format(time.RFC3339)))
+			case "=":
+				filter = append(filter, fmt.Sprintf("activityDateTime eq %s", givenTime.Format(time.RFC3339)))
+			case "<=":
+				filter = append(filter, fmt.Sprintf("activityDateTime le %s", givenTime.Format(time.RFC3339)))
+			case "<":
+				startTime := givenTime.Add(time.Duration(-1) * time.Second).Format(time.RFC3339)
+				filter = append(filter, fmt.Sprintf("activityDateTime le %s", startTime))
+			}
+		}
+	}
+
+	if len(filter) > 0 {
+		joinStr := strings.Join(filter, " and ")
+		input.Filter = &joinStr
+	}
+
+	options := &auditlogs.ProvisioningRequestBuilderGetRequestConfiguration{
+		QueryParameters: input,
+	}
+
+	result, err := client.AuditLogs().Provisioning().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdProvisioningLogs", "list_provisioning_log_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateProvisioningObjectSummaryCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.ProvisioningObjectSummaryable) bool {
+		d.StreamListItem(ctx, &ADProvisioningObjectSummaryInfo{pageItem})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdProvisioningLogs", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,179 @@
+package azuread
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/identityprotection"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// Identity Protection requires an Azure AD Premium P2 license, without which Graph rejects the requests
+var riskDetectionIgnorableErrors = []string{"not licensed", "Authentication_RequestFromNonPremiumTenantOrB2CTenant"}
+
+//// TABLE DEFINITION
+
+func tableAzureAdRiskDetection(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_risk_detection",
+		Description: "Represents a risk detected by Azure AD Identity Protection on a user or a sign-in, such as an impossible travel or leaked credentials.",
+		Get: &plugin.GetConfig{
+			Hydrate: getAdRiskDetection,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate(append([]string{"Request_ResourceNotFound", "ResourceNotFound"}, riskDetectionIgnorableErrors...)),
+			},
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAdRiskDetections,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate(riskDetectionIgnorableErrors),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				// Key fields
+				{Name: "detected_date_time", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
+				{Name: "risk_event_type", Require: plugin.Optional},
+				{Name: "risk_level", Require: plugin.Optional},
+				{Name: "risk_state", Require: plugin.Optional},
+				{Name: "user_id", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the risk detection.", Transform: transform.FromMethod("GetId")},
+			{Name: "risk_event_type", Type: proto.ColumnType_STRING, Description: "The type of the risk detected, e.g. unlikelyTravel, leakedCredentials, anonymizedIPAddress or maliciousIPAddress.", Transform: transform.FromMethod("GetRiskEventType")},
+			{Name: "risk_level", Type: proto.ColumnType_STRING, Description: "The level of the risk detected. Possible values are: low, medium, high, hidden, none, unknownFutureValue.", Transform: transform.FromMethod("RiskDetectionRiskLevel")},
+			{Name: "risk_state", Type: proto.ColumnType_STRING, Description: "The state of the risk. Possible values are: none, confirmedSafe, remediated, dismissed, atRisk, confirmedCompromised, unknownFutureValue.", Transform: transform.FromMethod("RiskDetectionRiskState")},
+			{Name: "risk_detail", Type: proto.ColumnType_STRING, Description: "The reason of the state of the risk, e.g. userPerformedSecuredPasswordChange or adminDismissedAllRiskForUser.", Transform: transform.FromMethod("RiskDetectionRiskDetail")},
+			{Name: "user_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the user at risk.", Transform: transform.FromMethod("GetUserId")},
+			{Name: "user_principal_name", Type: proto.ColumnType_STRING, Description: "The user principal name of the user at risk.", Transform: transform.FromMethod("GetUserPrincipalName")},
+			{Name: "ip_address", Type: proto.ColumnType_STRING, Description: "The IP address of the client of the risky activity.", Transform: transform.FromMethod("GetIpAddress")},
+			{Name: "detected_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the risk was detected.", Transform: transform.FromMethod("GetDetectedDateTime")},
+			{Name: "activity", Type: proto.ColumnType_STRING, Description: "The type of the activity the risk was detected on. Possible values are: signin, user, unknownFutureValue.", Transform: transform.FromMethod("RiskDetectionActivity")},
+
+			// Other fields
+			{Name: "user_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the user at risk.", Transform: transform.FromMethod("GetUserDisplayName")},
+			{Name: "activity_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the risky activity occurred.", Transform: transform.FromMethod("GetActivityDateTime")},
+			{Name: "last_updated_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the risk detection was last updated.", Transform: transform.FromMethod("GetLastUpdatedDateTime")},
+			{Name: "detection_timing_type", Type: proto.ColumnType_STRING, Description: "The timing of the detection. Possible values are: notDefined, realtime, nearRealtime, offline, unknownFutureValue.", Transform: transform.FromMethod("RiskDetectionDetectionTimingType")},
+			{Name: "source", Type: proto.ColumnType_STRING, Description: "The source of the detection, e.g. IdentityProtection.", Transform: transform.FromMethod("GetSource")},
+			{Name: "request_id", Type: proto.ColumnType_STRING, Description: "The request ID of the sign-in the risk was detected on.", Transform: transform.FromMethod("GetRequestId")},
+			{Name: "correlation_id", Type: proto.ColumnType_STRING, Description: "The correlation ID of the sign-in the risk was detected on.", Transform: transform.FromMethod("GetCorrelationId")},
+
+			// JSON fields
+			{Name: "location", Type: proto.ColumnType_JSON, Description: "The location of the sign-in the risk was detected on.", Transform: transform.FromMethod("RiskDetectionLocation")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetId")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdRiskDetections(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_risk_detection.listAdRiskDetections", "connection_error", err)
+		return nil, err
+	}
+
+	input := &identityprotection.RiskDetectionsRequestBuilderGetQueryParameters{}
+
+	var filter []string
+
+	equalQuals := map[string]string{
+		"risk_event_type": "riskEventType",
+		"risk_level":      "riskLevel",
+		"risk_state":      "riskState",
+		"user_id":         "userId",
+	}
+	for qual, property := range equalQuals {
+		if d.EqualsQuals[qual] != nil {
+			filter = append(filter, fmt.Sprintf("%s eq '%s'", property, escapeODataString(d.EqualsQuals[qual].GetStringValue())))
+		}
+	}
+
+	// Filter by detectedDateTime
+	if d.Quals["detected_date_time"] != nil {
+		for _, q := range d.Quals["detected_date_time"].Quals {
+			givenTime := q.Value.GetTimestampValue().AsTime()
+
+			switch q.Operator {
+			case ">":
+				startTime := givenTime.Add(time.Second * 1).Format(time.RFC3339)
+				filter = append(filter, fmt.Sprintf("detectedDateTime ge %s", startTime))
+			case ">=":
+				filter = append(filter, fmt.Sprintf("detectedDateTime ge %s", givenTime.Format(time.RFC3339)))
+			case "=":
+				filter = append(filter, fmt.Sprintf("detectedDateTime eq %s", givenTime.Format(time.RFC3339)))
+			case "<=":
+				filter = append(filter, fmt.Sprintf("detectedDateTime le %s", givenTime.Format(time.RFC3339)))
+			case "<":
+				startTime := givenTime.Add(time.Duration(-1) * time.Second).Format(time.RFC3339)
+				filter = append(filter, fmt.Sprintf("detectedDateTime le %s", startTime))
+			}
+		}
+	}
+
+	if len(filter) > 0 {
+		joinStr := strings.Join(filter, " and ")
+		input.Filter = &joinStr
+	}
+
+	options := &identityprotection.RiskDetectionsRequestBuilderGetRequestConfiguration{
+		QueryParameters: input,
+	}
+
+	result, err := client.IdentityProtection().RiskDetections().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdRiskDetections", "list_risk_detection_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateRiskDetectionCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.RiskDetectionable) bool {
+		d.StreamListItem(ctx, &ADRiskDetectionInfo{pageItem})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdRiskDetections", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAdRiskDetection(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	riskDetectionId := d.EqualsQuals["id"].GetStringValue()
+	if riskDetectionId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_risk_detection.getAdRiskDetection", "connection_error", err)
+		return nil, err
+	}
+
+	riskDetection, err := client.IdentityProtection().RiskDetections().ByRiskDetectionId(riskDetectionId).Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getAdRiskDetection", "get_risk_detection_error", errObj)
+		return nil, errObj
+	}
+
+	return &ADRiskDetectionInfo{riskDetection}, nil
+}
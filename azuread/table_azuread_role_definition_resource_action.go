@@ -0,0 +1,149 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdRoleDefinitionResourceAction(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_role_definition_resource_action",
+		Description: "Represents an action which can be allowed in a directory role definition, such as the actions referenced by the allowed resource actions of a custom role.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdRoleDefinitionResourceActions,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "namespace", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "action_name", Type: proto.ColumnType_STRING, Description: "The name of the action, e.g. microsoft.directory/applications/credentials/update.", Transform: transform.FromMethod("GetName")},
+			{Name: "namespace", Type: proto.ColumnType_STRING, Description: "The resource namespace the action belongs to, e.g. microsoft.directory.", Transform: transform.FromField("Namespace")},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the action.", Transform: transform.FromMethod("GetDescription")},
+			{Name: "is_privileged", Type: proto.ColumnType_BOOL, Description: "Indicates whether the action is privileged, i.e. whether it can be used to compromise the tenant. Null when not returned by the Microsoft Graph API.", Transform: transform.FromMethod("RoleDefinitionResourceActionIsPrivileged")},
+
+			// Other fields
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the action.", Transform: transform.FromMethod("GetId")},
+			{Name: "action_verb", Type: proto.ColumnType_STRING, Description: "The HTTP method of the action, e.g. GET or PATCH.", Transform: transform.FromMethod("GetActionVerb")},
+			{Name: "authentication_context_id", Type: proto.ColumnType_STRING, Description: "The identifier of the authentication context required to perform the action.", Transform: transform.FromMethod("GetAuthenticationContextId")},
+			{Name: "is_authentication_context_settable", Type: proto.ColumnType_BOOL, Description: "Indicates whether an authentication context can be required to perform the action.", Transform: transform.FromMethod("GetIsAuthenticationContextSettable")},
+			{Name: "resource_scope_id", Type: proto.ColumnType_STRING, Description: "The identifier of the resource scope of the action.", Transform: transform.FromMethod("GetResourceScopeId")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetName")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdRoleDefinitionResourceActions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	resourceActions, err := getRoleDefinitionResourceActionsMemoized(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := ""
+	if d.EqualsQuals["namespace"] != nil {
+		namespace = d.EqualsQuals["namespace"].GetStringValue()
+	}
+
+	for _, resourceAction := range resourceActions.([]*ADRoleDefinitionResourceActionInfo) {
+		if namespace != "" && (resourceAction.Namespace == nil || *resourceAction.Namespace != namespace) {
+			continue
+		}
+
+		d.StreamListItem(ctx, resourceAction)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// The catalog of resource actions is large but only changes with the releases of Azure AD, so it is fetched once per connection
+var getRoleDefinitionResourceActionsMemoized = plugin.HydrateFunc(getRoleDefinitionResourceActionsUncached).Memoize()
+
+func getRoleDefinitionResourceActionsUncached(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_role_definition_resource_action.getRoleDefinitionResourceActionsUncached", "connection_error", err)
+		return nil, err
+	}
+
+	result, err := client.RoleManagement().Directory().ResourceNamespaces().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getRoleDefinitionResourceActionsUncached", "list_resource_namespace_error", errObj)
+		return nil, errObj
+	}
+
+	namespaces := []models.UnifiedRbacResourceNamespaceable{}
+	err = iteratePages(ctx, d, adapter, result, models.CreateUnifiedRbacResourceNamespaceCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.UnifiedRbacResourceNamespaceable) bool {
+		namespaces = append(namespaces, pageItem)
+		return true
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("getRoleDefinitionResourceActionsUncached", "paging_error", err)
+		return nil, err
+	}
+
+	resourceActions := []*ADRoleDefinitionResourceActionInfo{}
+	for _, namespace := range namespaces {
+		if namespace.GetId() == nil {
+			continue
+		}
+
+		actions, err := listAdResourceNamespaceActions(ctx, d, *namespace.GetId())
+		if err != nil {
+			return nil, err
+		}
+
+		for _, action := range actions {
+			resourceActions = append(resourceActions, &ADRoleDefinitionResourceActionInfo{action, namespace.GetName()})
+		}
+	}
+
+	return resourceActions, nil
+}
+
+func listAdResourceNamespaceActions(ctx context.Context, d *plugin.QueryData, namespaceId string) ([]models.UnifiedRbacResourceActionable, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_role_definition_resource_action.listAdResourceNamespaceActions", "connection_error", err)
+		return nil, err
+	}
+
+	result, err := client.RoleManagement().Directory().ResourceNamespaces().ByUnifiedRbacResourceNamespaceId(namespaceId).ResourceActions().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdResourceNamespaceActions", "list_resource_action_error", errObj, "namespace_id", namespaceId)
+		return nil, errObj
+	}
+
+	actions := []models.UnifiedRbacResourceActionable{}
+	err = iteratePages(ctx, d, adapter, result, models.CreateUnifiedRbacResourceActionCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.UnifiedRbacResourceActionable) bool {
+		actions = append(actions, pageItem)
+		return true
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdResourceNamespaceActions", "paging_error", err)
+		return nil, err
+	}
+
+	return actions, nil
+}
@@ -0,0 +1,186 @@
+package azuread
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/microsoft/kiota-abstractions-go/serialization"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/rolemanagement"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdRoleScheduleRequest(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_role_schedule_request",
+		Description: "Represents a request to assign, activate, extend or remove a directory role through Privileged Identity Management (PIM), either for an active assignment or for an eligibility.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdRoleScheduleRequests,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				// PIM requires an Azure AD Premium P2 license, there are no requests in tenants without it
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate(pimIgnorableErrors),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "request_type", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "principal_id", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the request.", Transform: transform.FromMethod("GetId")},
+			{Name: "request_type", Type: proto.ColumnType_STRING, Description: "The type of the role requested. Possible values are: assignment, for an active assignment, and eligibility.", Transform: transform.FromField("RequestType")},
+			{Name: "action", Type: proto.ColumnType_STRING, Description: "The operation requested. Possible values are: adminAssign, adminUpdate, adminRemove, selfActivate, selfDeactivate, adminExtend, adminRenew, selfExtend, selfRenew, unknownFutureValue.", Transform: transform.FromField("Action")},
+			{Name: "principal_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the principal the role is requested for.", Transform: transform.FromField("PrincipalId")},
+			{Name: "role_definition_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the role definition requested.", Transform: transform.FromField("RoleDefinitionId")},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the request, e.g. PendingApproval, Granted, Denied, Provisioned, Revoked, Canceled or Failed.", Transform: transform.FromMethod("GetStatus")},
+			{Name: "created_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the request was created.", Transform: transform.FromMethod("GetCreatedDateTime")},
+
+			// Other fields
+			{Name: "completed_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the request was completed.", Transform: transform.FromMethod("GetCompletedDateTime")},
+			{Name: "approval_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the approval of the request, if the role requires an approval.", Transform: transform.FromMethod("GetApprovalId")},
+			{Name: "directory_scope_id", Type: proto.ColumnType_STRING, Description: "The identifier of the directory object representing the scope of the role, e.g. / for the tenant or an administrative unit.", Transform: transform.FromField("DirectoryScopeId")},
+			{Name: "justification", Type: proto.ColumnType_STRING, Description: "The justification given by the requester.", Transform: transform.FromField("Justification")},
+			{Name: "target_schedule_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the schedule created or updated by the request.", Transform: transform.FromField("TargetScheduleId")},
+
+			// JSON fields
+			{Name: "created_by", Type: proto.ColumnType_JSON, Description: "The user or the app which created the request.", Transform: transform.FromMethod("RoleScheduleRequestCreatedBy")},
+			{Name: "schedule_info", Type: proto.ColumnType_JSON, Description: "The period of the role requested, with its start date and time and its expiration, which can be a duration, an end date and time, or none for a permanent role.", Transform: transform.FromMethod("RoleScheduleRequestScheduleInfo")},
+			{Name: "ticket_info", Type: proto.ColumnType_JSON, Description: "The ticket number and system given by the requester, if any.", Transform: transform.FromMethod("RoleScheduleRequestTicketInfo")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetId")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdRoleScheduleRequests(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_role_schedule_request.listAdRoleScheduleRequests", "connection_error", err)
+		return nil, err
+	}
+
+	var filter []string
+	if d.EqualsQuals["status"] != nil {
+		filter = append(filter, fmt.Sprintf("status eq '%s'", escapeODataString(d.EqualsQuals["status"].GetStringValue())))
+	}
+	if d.EqualsQuals["principal_id"] != nil {
+		filter = append(filter, fmt.Sprintf("principalId eq '%s'", escapeODataString(d.EqualsQuals["principal_id"].GetStringValue())))
+	}
+
+	var queryFilter *string
+	if len(filter) > 0 {
+		joinStr := strings.Join(filter, " and ")
+		queryFilter = &joinStr
+	}
+
+	// The assignment and the eligibility requests are read from their own collections, whose requests share the request properties
+	collections := []struct {
+		requestType string
+		get         func() (serialization.Parsable, error)
+		constructor serialization.ParsableFactory
+	}{
+		{
+			requestType: "assignment",
+			get: func() (serialization.Parsable, error) {
+				options := &rolemanagement.DirectoryRoleAssignmentScheduleRequestsRequestBuilderGetRequestConfiguration{
+					QueryParameters: &rolemanagement.DirectoryRoleAssignmentScheduleRequestsRequestBuilderGetQueryParameters{
+						Filter: queryFilter,
+					},
+				}
+				return client.RoleManagement().Directory().RoleAssignmentScheduleRequests().Get(ctx, options)
+			},
+			constructor: models.CreateUnifiedRoleAssignmentScheduleRequestCollectionResponseFromDiscriminatorValue,
+		},
+		{
+			requestType: "eligibility",
+			get: func() (serialization.Parsable, error) {
+				options := &rolemanagement.DirectoryRoleEligibilityScheduleRequestsRequestBuilderGetRequestConfiguration{
+					QueryParameters: &rolemanagement.DirectoryRoleEligibilityScheduleRequestsRequestBuilderGetQueryParameters{
+						Filter: queryFilter,
+					},
+				}
+				return client.RoleManagement().Directory().RoleEligibilityScheduleRequests().Get(ctx, options)
+			},
+			constructor: models.CreateUnifiedRoleEligibilityScheduleRequestCollectionResponseFromDiscriminatorValue,
+		},
+	}
+
+	requestType := d.EqualsQuals["request_type"].GetStringValue()
+
+	for _, collection := range collections {
+		if requestType != "" && requestType != collection.requestType {
+			continue
+		}
+
+		result, err := collection.get()
+		if err != nil {
+			errObj := getErrorObject(err)
+			plugin.Logger(ctx).Error("listAdRoleScheduleRequests", "list_role_schedule_request_error", errObj, "request_type", collection.requestType)
+			return nil, errObj
+		}
+
+		err = iteratePages(ctx, d, adapter, result, collection.constructor, nil, func(pageItem models.Requestable) bool {
+			d.StreamListItem(ctx, newADRoleScheduleRequestInfo(pageItem, collection.requestType))
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			return d.RowsRemaining(ctx) != 0
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("listAdRoleScheduleRequests", "paging_error", err, "request_type", collection.requestType)
+			return nil, err
+		}
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// newADRoleScheduleRequestInfo reads the properties specific to the role requests, which the assignment and the eligibility requests both have
+// without sharing an interface for them.
+func newADRoleScheduleRequestInfo(request models.Requestable, requestType string) *ADRoleScheduleRequestInfo {
+	info := &ADRoleScheduleRequestInfo{Requestable: request, RequestType: requestType}
+
+	var action *models.UnifiedRoleScheduleRequestActions
+	switch r := request.(type) {
+	case models.UnifiedRoleAssignmentScheduleRequestable:
+		action = r.GetAction()
+		info.PrincipalId = r.GetPrincipalId()
+		info.RoleDefinitionId = r.GetRoleDefinitionId()
+		info.DirectoryScopeId = r.GetDirectoryScopeId()
+		info.Justification = r.GetJustification()
+		info.TargetScheduleId = r.GetTargetScheduleId()
+		info.ScheduleInfo = r.GetScheduleInfo()
+		info.TicketInfo = r.GetTicketInfo()
+	case models.UnifiedRoleEligibilityScheduleRequestable:
+		action = r.GetAction()
+		info.PrincipalId = r.GetPrincipalId()
+		info.RoleDefinitionId = r.GetRoleDefinitionId()
+		info.DirectoryScopeId = r.GetDirectoryScopeId()
+		info.Justification = r.GetJustification()
+		info.TargetScheduleId = r.GetTargetScheduleId()
+		info.ScheduleInfo = r.GetScheduleInfo()
+		info.TicketInfo = r.GetTicketInfo()
+	}
+
+	if action != nil {
+		actionStr := action.String()
+		info.Action = &actionStr
+	}
+
+	return info
+}
@@ -0,0 +1,125 @@
+package azuread
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/security"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdSecureScore(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_secure_score",
+		Description: "Represents a daily snapshot of the Microsoft Secure Score of the tenant, with the score of each security control.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdSecureScores,
+			KeyColumns: plugin.KeyColumnSlice{
+				// Key fields
+				{Name: "created_date_time", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the secure score snapshot.", Transform: transform.FromMethod("GetId")},
+			{Name: "created_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the secure score snapshot was created.", Transform: transform.FromMethod("GetCreatedDateTime")},
+			{Name: "current_score", Type: proto.ColumnType_DOUBLE, Description: "The score of the tenant on the date of the snapshot.", Transform: transform.FromMethod("GetCurrentScore")},
+			{Name: "max_score", Type: proto.ColumnType_DOUBLE, Description: "The maximum score the tenant could have reached on the date of the snapshot.", Transform: transform.FromMethod("GetMaxScore")},
+
+			// Other fields
+			{Name: "active_user_count", Type: proto.ColumnType_INT, Description: "The number of active users in the tenant.", Transform: transform.FromMethod("GetActiveUserCount")},
+			{Name: "licensed_user_count", Type: proto.ColumnType_INT, Description: "The number of licensed users in the tenant.", Transform: transform.FromMethod("GetLicensedUserCount")},
+
+			// JSON fields
+			{Name: "enabled_services", Type: proto.ColumnType_JSON, Description: "The Microsoft services the tenant has enabled, e.g. exchange, sharepoint.", Transform: transform.FromMethod("GetEnabledServices")},
+			{Name: "control_scores", Type: proto.ColumnType_JSON, Description: "The score of each security control on the date of the snapshot.", Transform: transform.FromMethod("SecureScoreControlScores")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetId")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdSecureScores(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_secure_score.listAdSecureScores", "connection_error", err)
+		return nil, err
+	}
+
+	// List operations
+	input := &security.SecureScoresRequestBuilderGetQueryParameters{}
+
+	// The snapshots are daily, so the limit is only used as page size when lower than the default one
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 100 {
+			l := int32(*limit)
+			input.Top = Int32(l)
+		}
+	}
+
+	var filter []string
+
+	// Filter by createdDateTime
+	if d.Quals["created_date_time"] != nil {
+		for _, q := range d.Quals["created_date_time"].Quals {
+			givenTime := q.Value.GetTimestampValue().AsTime()
+
+			switch q.Operator {
+			case ">":
+				startTime := givenTime.Add(time.Second * 1).Format(time.RFC3339)
+				filter = append(filter, fmt.Sprintf("createdDateTime ge %s", startTime))
+			case ">=":
+				filter = append(filter, fmt.Sprintf("createdDateTime ge %s", givenTime.Format(time.RFC3339)))
+			case "=":
+				filter = append(filter, fmt.Sprintf("createdDateTime eq %s", givenTime.Format(time.RFC3339)))
+			case "<=":
+				filter = append(filter, fmt.Sprintf("createdDateTime le %s", givenTime.Format(time.RFC3339)))
+			case "<":
+				startTime := givenTime.Add(time.Duration(-1) * time.Second).Format(time.RFC3339)
+				filter = append(filter, fmt.Sprintf("createdDateTime le %s", startTime))
+			}
+		}
+	}
+
+	if len(filter) > 0 {
+		joinStr := strings.Join(filter, " and ")
+		input.Filter = &joinStr
+	}
+
+	options := &security.SecureScoresRequestBuilderGetRequestConfiguration{
+		QueryParameters: input,
+	}
+
+	result, err := client.Security().SecureScores().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdSecureScores", "list_secure_score_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateSecureScoreCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.SecureScoreable) bool {
+		d.StreamListItem(ctx, &ADSecureScoreInfo{pageItem})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdSecureScores", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,101 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdSecureScoreControlProfile(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_secure_score_control_profile",
+		Description: "Represents a security control of the Microsoft Secure Score, with the actions to implement it and the points it is worth.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdSecureScoreControlProfiles,
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the control, as referenced by the controlName of the control scores of a secure score.", Transform: transform.FromMethod("GetId")},
+			{Name: "service", Type: proto.ColumnType_STRING, Description: "The service the control applies to, e.g. AzureAD, EXO, SPO.", Transform: transform.FromMethod("GetService")},
+			{Name: "control_category", Type: proto.ColumnType_STRING, Description: "The category of the control. Possible values are: Identity, Data, Device, Apps, Infrastructure.", Transform: transform.FromMethod("GetControlCategory")},
+			{Name: "action_type", Type: proto.ColumnType_STRING, Description: "The type of the action to implement the control. Possible values are: Config, Review, Behavior.", Transform: transform.FromMethod("GetActionType")},
+			{Name: "max_score", Type: proto.ColumnType_DOUBLE, Description: "The maximum number of points the control is worth.", Transform: transform.FromMethod("GetMaxScore")},
+
+			// Other fields
+			{Name: "action_url", Type: proto.ColumnType_STRING, Description: "The URL where the control can be actioned.", Transform: transform.FromMethod("GetActionUrl")},
+			{Name: "deprecated", Type: proto.ColumnType_BOOL, Description: "Indicates whether the control is deprecated.", Transform: transform.FromMethod("GetDeprecated")},
+			{Name: "implementation_cost", Type: proto.ColumnType_STRING, Description: "The cost of implementing the control. Possible values are: low, moderate, high.", Transform: transform.FromMethod("GetImplementationCost")},
+			{Name: "last_modified_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the control was last modified.", Transform: transform.FromMethod("GetLastModifiedDateTime")},
+			{Name: "rank", Type: proto.ColumnType_INT, Description: "The rank of the control by Microsoft, from the most to the least effective.", Transform: transform.FromMethod("GetRank")},
+			{Name: "remediation", Type: proto.ColumnType_STRING, Description: "The description of what the control will help remediate.", Transform: transform.FromMethod("GetRemediation")},
+			{Name: "remediation_impact", Type: proto.ColumnType_STRING, Description: "The description of the impact on users of the remediation.", Transform: transform.FromMethod("GetRemediationImpact")},
+			{Name: "tier", Type: proto.ColumnType_STRING, Description: "The tier of the control. Possible values are: Core, Defense in Depth, Advanced.", Transform: transform.FromMethod("GetTier")},
+			{Name: "user_impact", Type: proto.ColumnType_STRING, Description: "The impact on users of implementing the control. Possible values are: low, moderate, high.", Transform: transform.FromMethod("GetUserImpact")},
+
+			// JSON fields
+			{Name: "threats", Type: proto.ColumnType_JSON, Description: "The threats the control mitigates, e.g. accountBreach, dataExfiltration.", Transform: transform.FromMethod("GetThreats")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: "The title of the control.", Transform: transform.FromMethod("GetTitle")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdSecureScoreControlProfiles(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	controlProfiles, err := getSecureScoreControlProfilesMemoized(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, controlProfile := range controlProfiles.([]models.SecureScoreControlProfileable) {
+		d.StreamListItem(ctx, &ADSecureScoreControlProfileInfo{controlProfile})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// The control profiles are defined by Microsoft and rarely change, so they are fetched once per connection
+var getSecureScoreControlProfilesMemoized = plugin.HydrateFunc(getSecureScoreControlProfilesUncached).Memoize()
+
+func getSecureScoreControlProfilesUncached(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_secure_score_control_profile.getSecureScoreControlProfilesUncached", "connection_error", err)
+		return nil, err
+	}
+
+	result, err := client.Security().SecureScoreControlProfiles().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getSecureScoreControlProfilesUncached", "list_secure_score_control_profile_error", errObj)
+		return nil, errObj
+	}
+
+	controlProfiles := []models.SecureScoreControlProfileable{}
+	err = iteratePages(ctx, d, adapter, result, models.CreateSecureScoreControlProfileCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.SecureScoreControlProfileable) bool {
+		controlProfiles = append(controlProfiles, pageItem)
+		return true
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("getSecureScoreControlProfilesUncached", "paging_error", err)
+		return nil, err
+	}
+
+	return controlProfiles, nil
+}
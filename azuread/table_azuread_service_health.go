@@ -0,0 +1,63 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdServiceHealth(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_service_health",
+		Description: "Represents the current health of a Microsoft 365 service of the tenant, as reported by Microsoft.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdServiceHealths,
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the service, e.g. Exchange.", Transform: transform.FromMethod("GetId")},
+			{Name: "service", Type: proto.ColumnType_STRING, Description: "The name of the service, e.g. Microsoft Entra.", Transform: transform.FromMethod("GetService")},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The current health status of the service, e.g. serviceOperational, investigating, serviceDegradation or serviceInterruption.", Transform: transform.FromMethod("ServiceHealthStatus")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetService")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdServiceHealths(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_service_health.listAdServiceHealths", "connection_error", err)
+		return nil, err
+	}
+
+	result, err := client.Admin().ServiceAnnouncement().HealthOverviews().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdServiceHealths", "list_health_overview_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateServiceHealthCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.ServiceHealthable) bool {
+		d.StreamListItem(ctx, &ADServiceHealthInfo{pageItem})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdServiceHealths", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,115 @@
+package azuread
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/admin"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdServiceMessage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_service_message",
+		Description: "Represents a message of the Microsoft 365 message center, announcing a change or a planned maintenance of a service.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdServiceMessages,
+			KeyColumns: plugin.KeyColumnSlice{
+				// Key fields
+				{Name: "start_date_time", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the message, e.g. MC172851.", Transform: transform.FromMethod("GetId")},
+			{Name: "title", Type: proto.ColumnType_STRING, Description: "The title of the message.", Transform: transform.FromMethod("GetTitle")},
+			{Name: "category", Type: proto.ColumnType_STRING, Description: "The category of the message. Possible values are: preventOrFixIssue, planForChange, stayInformed.", Transform: transform.FromMethod("ServiceMessageCategory")},
+			{Name: "severity", Type: proto.ColumnType_STRING, Description: "The severity of the message. Possible values are: normal, high, critical.", Transform: transform.FromMethod("ServiceMessageSeverity")},
+			{Name: "start_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The start time of the change or of the maintenance.", Transform: transform.FromMethod("GetStartDateTime")},
+			{Name: "is_major_change", Type: proto.ColumnType_BOOL, Description: "True if the message announces a major change, which requires an action from the administrators.", Transform: transform.FromMethod("GetIsMajorChange")},
+
+			// Other fields
+			{Name: "end_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The end time of the change or of the maintenance.", Transform: transform.FromMethod("GetEndDateTime")},
+			{Name: "action_required_by_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time by which an action is required from the administrators.", Transform: transform.FromMethod("GetActionRequiredByDateTime")},
+			{Name: "last_modified_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the message was last modified.", Transform: transform.FromMethod("GetLastModifiedDateTime")},
+
+			// JSON fields
+			{Name: "services", Type: proto.ColumnType_JSON, Description: "The services affected by the message.", Transform: transform.FromMethod("GetServices")},
+			{Name: "tags", Type: proto.ColumnType_JSON, Description: "The tags of the message, e.g. Updated message or Admin impact.", Transform: transform.FromMethod("GetTags")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdServiceMessages(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_service_message.listAdServiceMessages", "connection_error", err)
+		return nil, err
+	}
+
+	input := &admin.ServiceAnnouncementMessagesRequestBuilderGetQueryParameters{}
+
+	var filter []string
+
+	// Filter by startDateTime
+	if d.Quals["start_date_time"] != nil {
+		for _, q := range d.Quals["start_date_time"].Quals {
+			givenTime := q.Value.GetTimestampValue().AsTime()
+
+			switch q.Operator {
+			case ">":
+				startTime := givenTime.Add(time.Second * 1).Format(time.RFC3339)
+				filter = append(filter, fmt.Sprintf("startDateTime ge %s", startTime))
+			case ">=":
+				filter = append(filter, fmt.Sprintf("startDateTime ge %s", givenTime.Format(time.RFC3339)))
+			case "=":
+				filter = append(filter, fmt.Sprintf("startDateTime eq %s", givenTime.Format(time.RFC3339)))
+			case "<=":
+				filter = append(filter, fmt.Sprintf("startDateTime le %s", givenTime.Format(time.RFC3339)))
+			case "<":
+				startTime := givenTime.Add(time.Duration(-1) * time.Second).Format(time.RFC3339)
+				filter = append(filter, fmt.Sprintf("startDateTime le %s", startTime))
+			}
+		}
+	}
+
+	if len(filter) > 0 {
+		joinStr := strings.Join(filter, " and ")
+		input.Filter = &joinStr
+	}
+
+	options := &admin.ServiceAnnouncementMessagesRequestBuilderGetRequestConfiguration{
+		QueryParameters: input,
+	}
+
+	result, err := client.Admin().ServiceAnnouncement().Messages().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdServiceMessages", "list_service_message_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateServiceUpdateMessageCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.ServiceUpdateMessageable) bool {
+		d.StreamListItem(ctx, &ADServiceMessageInfo{pageItem})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdServiceMessages", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
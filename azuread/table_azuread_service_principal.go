@@ -7,11 +7,11 @@ import (
 
 	"github.com/iancoleman/strcase"
 	abstractions "github.com/microsoft/kiota-abstractions-go"
+	"github.com/turbot/go-kit/helpers"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
-	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 	"github.com/microsoftgraph/msgraph-sdk-go/serviceprincipals"
 )
@@ -36,9 +36,12 @@ func tableAzureAdServicePrincipal(_ context.Context) *plugin.Table {
 			},
 			KeyColumns: plugin.KeyColumnSlice{
 				// Key fields
+				{Name: "app_id", Require: plugin.Optional},
 				{Name: "display_name", Require: plugin.Optional},
 				{Name: "account_enabled", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				{Name: "app_role_assignment_required", Require: plugin.Optional, Operators: []string{"<>", "="}},
 				{Name: "service_principal_type", Require: plugin.Optional},
+				{Name: "tag", Require: plugin.Optional},
 			},
 		},
 
@@ -57,7 +60,14 @@ func tableAzureAdServicePrincipal(_ context.Context) *plugin.Table {
 			{Name: "app_description", Type: proto.ColumnType_STRING, Description: "The description exposed by the associated application.", Transform: transform.FromMethod("GetAppDescription")},
 			{Name: "description", Type: proto.ColumnType_STRING, Description: "Free text field to provide an internal end-user facing description of the service principal.", Transform: transform.FromMethod("GetDescription")},
 			{Name: "login_url", Type: proto.ColumnType_STRING, Description: "Specifies the URL where the service provider redirects the user to Azure AD to authenticate. Azure AD uses the URL to launch the application from Microsoft 365 or the Azure AD My Apps. When blank, Azure AD performs IdP-initiated sign-on for applications configured with SAML-based single sign-on.", Transform: transform.FromMethod("GetLoginUrl")},
+			{Name: "notes", Type: proto.ColumnType_STRING, Description: "Free text field to capture information about the service principal, typically used for operational purposes.", Transform: transform.FromMethod("GetNotes")},
+			{Name: "preferred_token_signing_key_thumbprint", Type: proto.ColumnType_STRING, Description: "The thumbprint of the certificate used to sign the SAML tokens issued for the application. Set for the applications configured with SAML-based single sign-on.", Transform: transform.FromMethod("GetPreferredTokenSigningKeyThumbprint")},
 			{Name: "logout_url", Type: proto.ColumnType_STRING, Description: "Specifies the URL that will be used by Microsoft's authorization service to logout an user using OpenId Connect front-channel, back-channel or SAML logout protocols.", Transform: transform.FromMethod("GetLogoutUrl")},
+			{Name: "has_expiring_credentials", Type: proto.ColumnType_BOOL, Description: "True if any key or password credential of the service principal expires within the credential_expiry_warning_days of the connection, 30 days by default.", Transform: transform.FromMethod("ServicePrincipalHasExpiringCredentials")},
+			{Name: "has_expired_credentials", Type: proto.ColumnType_BOOL, Description: "True if any key or password credential of the service principal has already expired.", Transform: transform.FromMethod("ServicePrincipalHasExpiredCredentials")},
+			{Name: "is_gallery_app", Type: proto.ColumnType_BOOL, Description: "True if the service principal was added from the Azure AD application gallery, based on its WindowsAzureActiveDirectoryGalleryApplication tags.", Transform: transform.FromMethod("ServicePrincipalIsGalleryApp")},
+			{Name: "tag", Type: proto.ColumnType_STRING, Description: "A tag of the service principal, used to filter the service principals carrying it, e.g. WindowsAzureActiveDirectoryIntegratedApp.", Transform: transform.FromQual("tag")},
+			{Name: "earliest_credential_expiry", Type: proto.ColumnType_TIMESTAMP, Description: "The earliest expiry date of the key and password credentials of the service principal.", Transform: transform.FromMethod("ServicePrincipalEarliestCredentialExpiry")},
 
 			// JSON fields
 			{Name: "add_ins", Type: proto.ColumnType_JSON, Description: "Defines custom behavior that a consuming service can use to call an app in specific contexts.", Transform: transform.FromMethod("ServicePrincipalAddIns")},
@@ -72,6 +82,8 @@ func tableAzureAdServicePrincipal(_ context.Context) *plugin.Table {
 			{Name: "reply_urls", Type: proto.ColumnType_JSON, Description: "The URLs that user tokens are sent to for sign in with the associated application, or the redirect URIs that OAuth 2.0 authorization codes and access tokens are sent to for the associated application.", Transform: transform.FromMethod("GetReplyUrls")},
 			{Name: "service_principal_names", Type: proto.ColumnType_JSON, Description: "Contains the list of identifiersUris, copied over from the associated application. Additional values can be added to hybrid applications. These values can be used to identify the permissions exposed by this app within Azure AD.", Transform: transform.FromMethod("GetServicePrincipalNames")},
 			{Name: "tags_src", Type: proto.ColumnType_JSON, Description: "Custom strings that can be used to categorize and identify the service principal.", Transform: transform.FromMethod("GetTags")},
+			{Name: "custom_security_attributes", Type: proto.ColumnType_JSON, Description: "The custom security attributes assigned to the service principal, by attribute set, e.g. {\"Engineering\": {\"Project\": \"Baker\"}}. Requires the Attribute Assignment Reader role.", Transform: transform.From(adServicePrincipalCustomSecurityAttributes)},
+			rawColumn(),
 
 			// Standard columns
 			{Name: "tags", Type: proto.ColumnType_JSON, Description: ColumnDescriptionTags, Transform: transform.From(adServicePrincipalTags)},
@@ -92,7 +104,8 @@ func listAdServicePrincipals(ctx context.Context, d *plugin.QueryData, _ *plugin
 
 	// List operations
 	input := &serviceprincipals.ServicePrincipalsRequestBuilderGetQueryParameters{
-		Top: Int32(999),
+		Top:    Int32(999),
+		Select: buildServicePrincipalSelect(d.QueryContext.Columns),
 	}
 
 	// Restrict the limit value to be passed in the query parameter which is not between 1 and 999, otherwise API will throw an error as follow
@@ -133,14 +146,9 @@ func listAdServicePrincipals(ctx context.Context, d *plugin.QueryData, _ *plugin
 		return nil, errObj
 	}
 
-	pageIterator, err := msgraphcore.NewPageIterator[models.ServicePrincipalable](result, adapter, models.CreateServicePrincipalCollectionResponseFromDiscriminatorValue)
-	if err != nil {
-		plugin.Logger(ctx).Error("listAdServicePrincipals", "create_iterator_instance_error", err)
-		return nil, err
-	}
-
-	err = pageIterator.Iterate(ctx, func(pageItem models.ServicePrincipalable) bool {
-		d.StreamListItem(ctx, &ADServicePrincipalInfo{pageItem})
+	expiryWarningPeriod := getCredentialExpiryWarningPeriod(d)
+	err = iteratePages(ctx, d, adapter, result, models.CreateServicePrincipalCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.ServicePrincipalable) bool {
+		d.StreamListItem(ctx, &ADServicePrincipalInfo{pageItem, expiryWarningPeriod})
 
 		// Context can be cancelled due to manual cancellation or the limit has been hit
 		return d.RowsRemaining(ctx) != 0
@@ -169,14 +177,20 @@ func getAdServicePrincipal(ctx context.Context, d *plugin.QueryData, h *plugin.H
 		return nil, err
 	}
 
-	servicePrincipal, err := client.ServicePrincipals().ByServicePrincipalId(servicePrincipalID).Get(ctx, nil)
+	options := &serviceprincipals.ServicePrincipalItemRequestBuilderGetRequestConfiguration{
+		QueryParameters: &serviceprincipals.ServicePrincipalItemRequestBuilderGetQueryParameters{
+			Select: buildServicePrincipalSelect(d.QueryContext.Columns),
+		},
+	}
+
+	servicePrincipal, err := client.ServicePrincipals().ByServicePrincipalId(servicePrincipalID).Get(ctx, options)
 	if err != nil {
 		errObj := getErrorObject(err)
 		plugin.Logger(ctx).Error("getAdServicePrincipal", "get_service_principal_error", errObj)
 		return nil, errObj
 	}
 
-	return &ADServicePrincipalInfo{servicePrincipal}, nil
+	return &ADServicePrincipalInfo{servicePrincipal, getCredentialExpiryWarningPeriod(d)}, nil
 }
 
 func getServicePrincipalOwners(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -214,13 +228,7 @@ func getServicePrincipalOwners(ctx context.Context, d *plugin.QueryData, h *plug
 		return nil, errObj
 	}
 
-	pageIterator, err := msgraphcore.NewPageIterator[models.DirectoryObjectable](owners, adapter, models.CreateDirectoryObjectCollectionResponseFromDiscriminatorValue)
-	if err != nil {
-		plugin.Logger(ctx).Error("getServicePrincipalOwners", "create_iterator_instance_error", err)
-		return nil, err
-	}
-
-	err = pageIterator.Iterate(ctx, func(pageItem models.DirectoryObjectable) bool {
+	err = iteratePages(ctx, d, adapter, owners, models.CreateDirectoryObjectCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.DirectoryObjectable) bool {
 		ownerIds = append(ownerIds, pageItem.GetId())
 
 		return true
@@ -236,6 +244,11 @@ func getServicePrincipalOwners(ctx context.Context, d *plugin.QueryData, h *plug
 
 //// TRANSFORM FUNCTIONS
 
+func adServicePrincipalCustomSecurityAttributes(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	servicePrincipal := d.HydrateItem.(*ADServicePrincipalInfo)
+	return customSecurityAttributeValues(ctx, servicePrincipal.GetCustomSecurityAttributes()), nil
+}
+
 func adServicePrincipalTags(ctx context.Context, d *transform.TransformData) (interface{}, error) {
 	servicePrincipal := d.HydrateItem.(*ADServicePrincipalInfo)
 	tags := servicePrincipal.GetTags()
@@ -260,16 +273,18 @@ func buildServicePrincipalQueryFilter(equalQuals plugin.KeyColumnEqualsQualMap)
 	filters := []string{}
 
 	filterQuals := map[string]string{
-		"display_name":           "string",
-		"account_enabled":        "bool",
-		"service_principal_type": "string",
+		"app_id":                       "string",
+		"display_name":                 "string",
+		"account_enabled":              "bool",
+		"app_role_assignment_required": "bool",
+		"service_principal_type":       "string",
 	}
 
 	for qual, qualType := range filterQuals {
 		switch qualType {
 		case "string":
 			if equalQuals[qual] != nil {
-				filters = append(filters, fmt.Sprintf("%s eq '%s'", strcase.ToCamel(qual), equalQuals[qual].GetStringValue()))
+				filters = append(filters, fmt.Sprintf("%s eq '%s'", strcase.ToCamel(qual), escapeODataString(equalQuals[qual].GetStringValue())))
 			}
 		case "bool":
 			if equalQuals[qual] != nil {
@@ -278,6 +293,11 @@ func buildServicePrincipalQueryFilter(equalQuals plugin.KeyColumnEqualsQualMap)
 		}
 	}
 
+	// The tags are a collection, so the service principals carrying the tag are matched with a lambda operator
+	if equalQuals["tag"] != nil {
+		filters = append(filters, fmt.Sprintf("tags/any(t:t eq '%s')", escapeODataString(equalQuals["tag"].GetStringValue())))
+	}
+
 	return filters
 }
 
@@ -286,6 +306,7 @@ func buildServicePrincipalBoolNEFilter(quals plugin.KeyColumnQualMap) []string {
 
 	filterQuals := []string{
 		"account_enabled",
+		"app_role_assignment_required",
 	}
 
 	for _, qual := range filterQuals {
@@ -302,3 +323,23 @@ func buildServicePrincipalBoolNEFilter(quals plugin.KeyColumnQualMap) []string {
 
 	return filters
 }
+
+// servicePrincipalProperties are the properties of a service principal read by the columns of the table
+var servicePrincipalProperties = []string{
+	"id", "displayName", "appId", "accountEnabled", "appDisplayName", "appOwnerOrganizationId", "appRoleAssignmentRequired",
+	"servicePrincipalType", "signInAudience", "appDescription", "description", "loginUrl", "notes", "preferredTokenSigningKeyThumbprint",
+	"logoutUrl", "keyCredentials", "passwordCredentials", "tags", "addIns", "alternativeNames", "appRoles", "info",
+	"notificationEmailAddresses", "oauth2PermissionScopes", "replyUrls", "servicePrincipalNames",
+}
+
+// buildServicePrincipalSelect returns the $select of the service principal requests. The custom security attributes are only returned
+// when selected, so they are requested along with all the other properties of the table when the custom_security_attributes column is queried.
+// Otherwise, no $select is sent and the default properties are returned.
+func buildServicePrincipalSelect(queryColumns []string) []string {
+	if !helpers.StringSliceContains(queryColumns, "custom_security_attributes") {
+		return nil
+	}
+
+	selectColumns := append([]string{}, servicePrincipalProperties...)
+	return append(selectColumns, "customSecurityAttributes")
+}
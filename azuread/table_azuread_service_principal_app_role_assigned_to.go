@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/iancoleman/strcase"
-	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 	"github.com/microsoftgraph/msgraph-sdk-go/serviceprincipals"
 
@@ -112,13 +111,7 @@ func listAdServicePrincipalAppRoleAssignedTo(ctx context.Context, d *plugin.Quer
 		return nil, errObj
 	}
 
-	pageIterator, err := msgraphcore.NewPageIterator[models.AppRoleAssignmentable](result, adapter, models.CreateAppRoleAssignmentCollectionResponseFromDiscriminatorValue)
-	if err != nil {
-		plugin.Logger(ctx).Error("listAdServicePrincipalAppRoleAssignedTo", "create_iterator_instance_error", err)
-		return nil, err
-	}
-
-	err = pageIterator.Iterate(ctx, func(pageItem models.AppRoleAssignmentable) bool {
+	err = iteratePages(ctx, d, adapter, result, models.CreateAppRoleAssignmentCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.AppRoleAssignmentable) bool {
 		d.StreamListItem(ctx, &ADAppRoleAssignmentInfo{pageItem})
 
 		// Context can be cancelled due to manual cancellation or the limit has been hit
@@ -0,0 +1,196 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/serviceprincipals"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdServicePrincipalCredential(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_service_principal_credential",
+		Description: "Represents a key (certificate) or password (client secret) credential of a service principal.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdServicePrincipalCredentials,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "service_principal_id", Require: plugin.Optional},
+				{Name: "credential_type", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "service_principal_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the service principal.", Transform: transform.FromField("ServicePrincipalId")},
+			{Name: "service_principal_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the service principal.", Transform: transform.FromField("ServicePrincipalDisplayName")},
+			{Name: "app_id", Type: proto.ColumnType_STRING, Description: "The application ID of the service principal.", Transform: transform.FromField("AppId")},
+			{Name: "credential_type", Type: proto.ColumnType_STRING, Description: "The kind of the credential. Possible values are: key, password.", Transform: transform.FromField("CredentialType")},
+			{Name: "key_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the credential.", Transform: transform.FromField("KeyId")},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The friendly name of the credential.", Transform: transform.FromField("DisplayName")},
+
+			// Other fields
+			{Name: "start_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the credential becomes valid.", Transform: transform.FromField("StartDateTime")},
+			{Name: "end_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the credential expires.", Transform: transform.FromField("EndDateTime")},
+			{Name: "custom_key_identifier", Type: proto.ColumnType_STRING, Description: "The custom key identifier of the credential, as a hex string. For a certificate, it is usually the thumbprint of the certificate.", Transform: transform.FromMethod("ServicePrincipalCredentialCustomKeyIdentifier")},
+			{Name: "usage", Type: proto.ColumnType_STRING, Description: "The purpose of a key credential. Possible values are: Sign, Verify. Null for a password credential.", Transform: transform.FromField("Usage")},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of a key credential. Possible values are: AsymmetricX509Cert, Symmetric. Null for a password credential.", Transform: transform.FromField("Type")},
+			{Name: "expiry_status", Type: proto.ColumnType_STRING, Description: "The expiry status of the credential. Possible values are: expired, expiring (within the credential_expiry_warning_days of the connection, 30 days by default), valid.", Transform: transform.FromMethod("ServicePrincipalCredentialExpiryStatus")},
+			{Name: "days_until_expiry", Type: proto.ColumnType_INT, Description: "The number of whole days left before the credential expires. Negative once the credential has expired.", Transform: transform.FromMethod("ServicePrincipalCredentialDaysUntilExpiry")},
+			{Name: "is_sni_enabled", Type: proto.ColumnType_BOOL, Description: "True if the credential is a verification certificate issued by a CA, which can be used for subject name and issuer (SNI) authentication. Null when the certificate is not returned by Graph, i.e. unless service_principal_id is specified.", Transform: transform.FromMethod("ServicePrincipalCredentialIsSniEnabled")},
+			{Name: "certificate_subject", Type: proto.ColumnType_STRING, Description: "The subject of the certificate of a key credential. Only returned when service_principal_id is specified.", Transform: transform.FromMethod("ServicePrincipalCredentialCertificateSubject")},
+			{Name: "certificate_issuer", Type: proto.ColumnType_STRING, Description: "The issuer of the certificate of a key credential. Only returned when service_principal_id is specified.", Transform: transform.FromMethod("ServicePrincipalCredentialCertificateIssuer")},
+			{Name: "hint", Type: proto.ColumnType_STRING, Description: "The first three characters of a password credential. Null for a key credential.", Transform: transform.FromField("Hint")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adServicePrincipalCredentialTitle)},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdServicePrincipalCredentials(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_service_principal_credential.listAdServicePrincipalCredentials", "connection_error", err)
+		return nil, err
+	}
+
+	selectFields := []string{"id", "displayName", "appId", "keyCredentials", "passwordCredentials"}
+
+	if d.EqualsQuals["service_principal_id"] != nil {
+		servicePrincipalId := d.EqualsQuals["service_principal_id"].GetStringValue()
+		if servicePrincipalId == "" {
+			return nil, nil
+		}
+
+		options := &serviceprincipals.ServicePrincipalItemRequestBuilderGetRequestConfiguration{
+			QueryParameters: &serviceprincipals.ServicePrincipalItemRequestBuilderGetQueryParameters{
+				Select: selectFields,
+			},
+		}
+
+		servicePrincipal, err := client.ServicePrincipals().ByServicePrincipalId(servicePrincipalId).Get(ctx, options)
+		if err != nil {
+			errObj := getErrorObject(err)
+			plugin.Logger(ctx).Error("listAdServicePrincipalCredentials", "get_service_principal_error", errObj)
+			return nil, errObj
+		}
+
+		streamAdServicePrincipalCredentials(ctx, d, servicePrincipal)
+		return nil, nil
+	}
+
+	// Without a service principal, the credentials of all the service principals are listed
+	options := &serviceprincipals.ServicePrincipalsRequestBuilderGetRequestConfiguration{
+		QueryParameters: &serviceprincipals.ServicePrincipalsRequestBuilderGetQueryParameters{
+			Top:    Int32(999),
+			Select: selectFields,
+		},
+	}
+
+	result, err := client.ServicePrincipals().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdServicePrincipalCredentials", "list_service_principal_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateServicePrincipalCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.ServicePrincipalable) bool {
+		return streamAdServicePrincipalCredentials(ctx, d, pageItem)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdServicePrincipalCredentials", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// streamAdServicePrincipalCredentials streams a row per key and password credential of the service principal, and returns false once the limit has been hit
+func streamAdServicePrincipalCredentials(ctx context.Context, d *plugin.QueryData, servicePrincipal models.ServicePrincipalable) bool {
+	credentialType := d.EqualsQuals["credential_type"].GetStringValue()
+	expiryWarningPeriod := getCredentialExpiryWarningPeriod(d)
+
+	credentials := []*ADServicePrincipalCredentialInfo{}
+	if credentialType == "" || credentialType == "key" {
+		for _, c := range servicePrincipal.GetKeyCredentials() {
+			credential := &ADServicePrincipalCredentialInfo{
+				ServicePrincipalId:          servicePrincipal.GetId(),
+				ServicePrincipalDisplayName: servicePrincipal.GetDisplayName(),
+				AppId:                       servicePrincipal.GetAppId(),
+				CredentialType:              "key",
+				DisplayName:                 c.GetDisplayName(),
+				StartDateTime:               c.GetStartDateTime(),
+				EndDateTime:                 c.GetEndDateTime(),
+				CustomKeyIdentifier:         c.GetCustomKeyIdentifier(),
+				Key:                         c.GetKey(),
+				Usage:                       c.GetUsage(),
+				Type:                        c.GetTypeEscaped(),
+				ExpiryWarningPeriod:         expiryWarningPeriod,
+			}
+			if c.GetKeyId() != nil {
+				keyId := c.GetKeyId().String()
+				credential.KeyId = &keyId
+			}
+			credentials = append(credentials, credential)
+		}
+	}
+	if credentialType == "" || credentialType == "password" {
+		for _, c := range servicePrincipal.GetPasswordCredentials() {
+			credential := &ADServicePrincipalCredentialInfo{
+				ServicePrincipalId:          servicePrincipal.GetId(),
+				ServicePrincipalDisplayName: servicePrincipal.GetDisplayName(),
+				AppId:                       servicePrincipal.GetAppId(),
+				CredentialType:              "password",
+				DisplayName:                 c.GetDisplayName(),
+				StartDateTime:               c.GetStartDateTime(),
+				EndDateTime:                 c.GetEndDateTime(),
+				CustomKeyIdentifier:         c.GetCustomKeyIdentifier(),
+				Hint:                        c.GetHint(),
+				ExpiryWarningPeriod:         expiryWarningPeriod,
+			}
+			if c.GetKeyId() != nil {
+				keyId := c.GetKeyId().String()
+				credential.KeyId = &keyId
+			}
+			credentials = append(credentials, credential)
+		}
+	}
+
+	for _, credential := range credentials {
+		d.StreamListItem(ctx, credential)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+//// TRANSFORM FUNCTIONS
+
+func adServicePrincipalCredentialTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADServicePrincipalCredentialInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	title := data.DisplayName
+	if title == nil {
+		title = data.KeyId
+	}
+
+	return title, nil
+}
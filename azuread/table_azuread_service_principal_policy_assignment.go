@@ -0,0 +1,134 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoft/kiota-abstractions-go/serialization"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdServicePrincipalPolicyAssignment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_service_principal_policy_assignment",
+		Description: "Represents a policy assigned to a service principal, of any of the claims mapping, home realm discovery, token issuance and token lifetime types.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdServicePrincipalPolicyAssignments,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "sp_id", Require: plugin.Required},
+				{Name: "policy_type", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "sp_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the service principal the policy is assigned to.", Transform: transform.FromField("ServicePrincipalId")},
+			{Name: "policy_type", Type: proto.ColumnType_STRING, Description: "The type of the policy. Possible values are: claimsMappingPolicy, homeRealmDiscoveryPolicy, tokenIssuancePolicy, tokenLifetimePolicy.", Transform: transform.FromField("PolicyType")},
+			{Name: "policy_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the policy.", Transform: transform.FromMethod("GetId")},
+			{Name: "policy_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the policy.", Transform: transform.FromMethod("GetDisplayName")},
+
+			// Other fields
+			{Name: "is_organization_default", Type: proto.ColumnType_BOOL, Description: "If set to true, the policy applies to all the applications of the tenant which have no policy of the same type assigned.", Transform: transform.FromMethod("GetIsOrganizationDefault")},
+
+			// JSON fields
+			{Name: "definition", Type: proto.ColumnType_JSON, Description: "A string collection containing a JSON string that defines the rules and settings of the policy.", Transform: transform.FromMethod("GetDefinition")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adServicePrincipalTokenPolicyTitle)},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdServicePrincipalPolicyAssignments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	servicePrincipalId := d.EqualsQuals["sp_id"].GetStringValue()
+	if servicePrincipalId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_service_principal_policy_assignment.listAdServicePrincipalPolicyAssignments", "connection_error", err)
+		return nil, err
+	}
+
+	servicePrincipal := client.ServicePrincipals().ByServicePrincipalId(servicePrincipalId)
+
+	// Each policy type is assigned through its own relationship, whose policies all share the stsPolicy properties
+	relationships := []struct {
+		policyType  string
+		get         func() (serialization.Parsable, error)
+		constructor serialization.ParsableFactory
+	}{
+		{
+			policyType: "claimsMappingPolicy",
+			get: func() (serialization.Parsable, error) {
+				return servicePrincipal.ClaimsMappingPolicies().Get(ctx, nil)
+			},
+			constructor: models.CreateClaimsMappingPolicyCollectionResponseFromDiscriminatorValue,
+		},
+		{
+			policyType: "homeRealmDiscoveryPolicy",
+			get: func() (serialization.Parsable, error) {
+				return servicePrincipal.HomeRealmDiscoveryPolicies().Get(ctx, nil)
+			},
+			constructor: models.CreateHomeRealmDiscoveryPolicyCollectionResponseFromDiscriminatorValue,
+		},
+		{
+			policyType: "tokenIssuancePolicy",
+			get: func() (serialization.Parsable, error) {
+				return servicePrincipal.TokenIssuancePolicies().Get(ctx, nil)
+			},
+			constructor: models.CreateTokenIssuancePolicyCollectionResponseFromDiscriminatorValue,
+		},
+		{
+			policyType: "tokenLifetimePolicy",
+			get: func() (serialization.Parsable, error) {
+				return servicePrincipal.TokenLifetimePolicies().Get(ctx, nil)
+			},
+			constructor: models.CreateTokenLifetimePolicyCollectionResponseFromDiscriminatorValue,
+		},
+	}
+
+	policyType := d.EqualsQuals["policy_type"].GetStringValue()
+
+	for _, relationship := range relationships {
+		if policyType != "" && policyType != relationship.policyType {
+			continue
+		}
+
+		result, err := relationship.get()
+		if err != nil {
+			errObj := getErrorObject(err)
+			plugin.Logger(ctx).Error("listAdServicePrincipalPolicyAssignments", "list_policy_error", errObj, "policy_type", relationship.policyType)
+			return nil, errObj
+		}
+
+		err = iteratePages(ctx, d, adapter, result, relationship.constructor, nil, func(pageItem models.StsPolicyable) bool {
+			d.StreamListItem(ctx, &ADServicePrincipalTokenPolicyInfo{pageItem, &servicePrincipalId, relationship.policyType})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			return d.RowsRemaining(ctx) != 0
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("listAdServicePrincipalPolicyAssignments", "paging_error", err, "policy_type", relationship.policyType)
+			return nil, err
+		}
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,155 @@
+package azuread
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/iancoleman/strcase"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdServicePrincipalSignIn(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_service_principal_sign_in",
+		Description: "Represents a sign-in performed by a service principal in Azure Active Directory (Azure AD).",
+		List: &plugin.ListConfig{
+			Hydrate: listAdServicePrincipalSignIns,
+			KeyColumns: plugin.KeyColumnSlice{
+				// Key fields
+				{Name: "app_id", Require: plugin.Optional},
+				{Name: "created_date_time", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
+				{Name: "resource_id", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique ID representing the sign-in activity.", Transform: transform.FromMethod("GetId")},
+			{Name: "created_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time (UTC) the sign-in was initiated.", Transform: transform.FromMethod("GetCreatedDateTime")},
+			{Name: "app_id", Type: proto.ColumnType_STRING, Description: "Unique GUID representing the app ID in the Azure Active Directory.", Transform: transform.FromMethod("GetAppId")},
+			{Name: "app_display_name", Type: proto.ColumnType_STRING, Description: "App name displayed in the Azure Portal.", Transform: transform.FromMethod("GetAppDisplayName")},
+			{Name: "service_principal_id", Type: proto.ColumnType_STRING, Description: "The application identifier used for sign-in.", Transform: transform.FromField("ServicePrincipalId")},
+			{Name: "service_principal_name", Type: proto.ColumnType_STRING, Description: "The application name used for sign-in.", Transform: transform.FromField("ServicePrincipalName")},
+			{Name: "ip_address", Type: proto.ColumnType_STRING, Description: "IP address of the client used to sign in.", Transform: transform.FromMethod("GetIpAddress")},
+			{Name: "correlation_id", Type: proto.ColumnType_STRING, Description: "The request ID sent from the client when the sign-in is initiated; used to troubleshoot sign-in activity.", Transform: transform.FromMethod("GetCorrelationId")},
+			{Name: "conditional_access_status", Type: proto.ColumnType_STRING, Description: "Reports status of an activated conditional access policy. Possible values are: success, failure, notApplied, and unknownFutureValue.", Transform: transform.FromMethod("GetConditionalAccessStatus")},
+			{Name: "resource_display_name", Type: proto.ColumnType_STRING, Description: "Name of the resource the service principal signed into.", Transform: transform.FromMethod("GetResourceDisplayName")},
+			{Name: "resource_id", Type: proto.ColumnType_STRING, Description: "ID of the resource that the service principal signed into.", Transform: transform.FromMethod("GetResourceId")},
+
+			// JSON fields
+			{Name: "status", Type: proto.ColumnType_JSON, Description: "Sign-in status. Includes the error code and description of the error (in case of a sign-in failure).", Transform: transform.FromMethod("SignInStatus")},
+			{Name: "location", Type: proto.ColumnType_JSON, Description: "Provides the city, state, and country code where the sign-in originated.", Transform: transform.FromMethod("SignInLocation")},
+			{Name: "applied_conditional_access_policies", Type: proto.ColumnType_JSON, Description: "Provides a list of conditional access policies that are triggered by the corresponding sign-in activity.", Transform: transform.FromMethod("SignInAppliedConditionalAccessPolicies")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetId")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdServicePrincipalSignIns(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	_, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_service_principal_sign_in.listAdServicePrincipalSignIns", "connection_error", err)
+		return nil, err
+	}
+
+	// List operations
+	top := 999
+
+	// Restrict the limit value to be passed in the query parameter which is not between 1 and 999, otherwise API will throw an error as follow
+	// unexpected status 400 with OData error: Request_UnsupportedQuery: Invalid page size specified: '1000'. Must be between 1 and 999 inclusive.
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 999 {
+			top = int(*limit)
+		}
+	}
+
+	// Only return the sign-ins where a service principal authenticated on its own behalf
+	filter := []string{"signInEventTypes/any(t: t eq 'servicePrincipal')"}
+	filter = append(filter, buildServicePrincipalSignInQueryFilter(d.EqualsQuals)...)
+
+	// Filter by createdDateTime
+	if d.Quals["created_date_time"] != nil {
+		for _, q := range d.Quals["created_date_time"].Quals {
+			givenTime := q.Value.GetTimestampValue().AsTime()
+
+			switch q.Operator {
+			case ">":
+				startTime := givenTime.Add(time.Second * 1).Format(time.RFC3339)
+				filter = append(filter, fmt.Sprintf("createdDateTime ge %s", startTime))
+			case ">=":
+				filter = append(filter, fmt.Sprintf("createdDateTime ge %s", givenTime.Format(time.RFC3339)))
+			case "=":
+				filter = append(filter, fmt.Sprintf("createdDateTime eq %s", givenTime.Format(time.RFC3339)))
+			case "<=":
+				filter = append(filter, fmt.Sprintf("createdDateTime le %s", givenTime.Format(time.RFC3339)))
+			case "<":
+				startTime := givenTime.Add(time.Duration(-1) * time.Second).Format(time.RFC3339)
+				filter = append(filter, fmt.Sprintf("createdDateTime le %s", startTime))
+			}
+		}
+	}
+
+	// The v1.0 API only returns the interactive user sign-ins, so the service principal sign-ins are requested from the beta API.
+	// The beta sign-ins are parsed with the v1.0 model, the properties missing from it are kept in the additional data.
+	query := url.Values{}
+	query.Set("$filter", strings.Join(filter, " and "))
+	query.Set("$top", strconv.Itoa(top))
+	signInsURL := betaRequestURL(adapter, "/auditLogs/signIns", query)
+
+	result, err := fetchPage(ctx, adapter, signInsURL, models.CreateSignInCollectionResponseFromDiscriminatorValue, nil, 1)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdServicePrincipalSignIns", "list_service_principal_sign_in_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateSignInCollectionResponseFromDiscriminatorValue, nil, func(signIn models.SignInable) bool {
+		servicePrincipalId := signIn.GetAdditionalData()["servicePrincipalId"]
+		servicePrincipalName := signIn.GetAdditionalData()["servicePrincipalName"]
+
+		d.StreamListItem(ctx, &ADServicePrincipalSignInInfo{ADSignInReportInfo{signIn}, servicePrincipalId, servicePrincipalName})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdServicePrincipalSignIns", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func buildServicePrincipalSignInQueryFilter(equalQuals plugin.KeyColumnEqualsQualMap) []string {
+	filters := []string{}
+
+	filterQuals := []string{
+		"app_id",
+		"resource_id",
+	}
+
+	for _, qual := range filterQuals {
+		if equalQuals[qual] != nil {
+			filters = append(filters, fmt.Sprintf("%s eq '%s'", strcase.ToLowerCamel(qual), escapeODataString(equalQuals[qual].GetStringValue())))
+		}
+	}
+
+	return filters
+}
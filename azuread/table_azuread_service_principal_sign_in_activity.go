@@ -0,0 +1,286 @@
+package azuread
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/microsoft/kiota-abstractions-go/serialization"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdServicePrincipalSignInActivity(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_service_principal_sign_in_activity",
+		Description: "Represents the last sign-ins of an application, as a client or as a resource, used to find the unused service principals.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdServicePrincipalSignInActivities,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				// The sign-in activity reports require an Azure AD Premium P1 license, there are no reports in tenants without it
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Authentication_RequestFromNonPremiumTenantOrB2CTenant"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "application_id", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the sign-in activity.", Transform: transform.FromField("Id")},
+			{Name: "application_id", Type: proto.ColumnType_STRING, Description: "The application ID of the application.", Transform: transform.FromField("AppId")},
+			{Name: "last_sign_in", Type: proto.ColumnType_TIMESTAMP, Description: "The time of the last sign-in of the application, as a client or as a resource, successful or not.", Transform: transform.FromMethod("ServicePrincipalSignInActivityLastSignIn")},
+			{Name: "last_successful_sign_in", Type: proto.ColumnType_TIMESTAMP, Description: "The time of the last successful sign-in of the application, as a client or as a resource.", Transform: transform.FromMethod("ServicePrincipalSignInActivityLastSuccessfulSignIn")},
+
+			// JSON fields
+			{Name: "last_sign_in_activity", Type: proto.ColumnType_JSON, Description: "The last sign-in of the application, as a client or as a resource, with its time and request ID.", Transform: transform.FromField("LastSignInActivity")},
+			{Name: "delegated_client_sign_in_activity", Type: proto.ColumnType_JSON, Description: "The last sign-in of the application as a client on behalf of a user.", Transform: transform.FromField("DelegatedClientSignInActivity")},
+			{Name: "delegated_resource_sign_in_activity", Type: proto.ColumnType_JSON, Description: "The last sign-in to the application as a resource on behalf of a user.", Transform: transform.FromField("DelegatedResourceSignInActivity")},
+			{Name: "application_authentication_client_sign_in_activity", Type: proto.ColumnType_JSON, Description: "The last sign-in of the application as a client on its own behalf, e.g. with a client secret or a certificate.", Transform: transform.FromField("ApplicationAuthenticationClientSignInActivity")},
+			{Name: "application_authentication_resource_sign_in_activity", Type: proto.ColumnType_JSON, Description: "The last sign-in to the application as a resource by an application on its own behalf.", Transform: transform.FromField("ApplicationAuthenticationResourceSignInActivity")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromField("AppId")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdServicePrincipalSignInActivities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	_, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_service_principal_sign_in_activity.listAdServicePrincipalSignInActivities", "connection_error", err)
+		return nil, err
+	}
+
+	query := url.Values{}
+	if d.EqualsQuals["application_id"] != nil {
+		query.Set("$filter", fmt.Sprintf("appId eq '%s'", escapeODataString(d.EqualsQuals["application_id"].GetStringValue())))
+	}
+
+	// The sign-in activities are only exposed by the beta API
+	activitiesURL := betaRequestURL(adapter, "/reports/servicePrincipalSignInActivities", query)
+
+	result, err := fetchPage(ctx, adapter, activitiesURL, createServicePrincipalSignInActivityCollectionResponse, nil, 1)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdServicePrincipalSignInActivities", "list_service_principal_sign_in_activity_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, createServicePrincipalSignInActivityCollectionResponse, nil, func(activity *servicePrincipalSignInActivity) bool {
+		d.StreamListItem(ctx, activity)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdServicePrincipalSignInActivities", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func (activity *servicePrincipalSignInActivity) ServicePrincipalSignInActivityLastSignIn() *time.Time {
+	if activity.LastSignInActivity == nil {
+		return nil
+	}
+	return activity.LastSignInActivity.LastSignInDateTime
+}
+
+func (activity *servicePrincipalSignInActivity) ServicePrincipalSignInActivityLastSuccessfulSignIn() *time.Time {
+	if activity.LastSignInActivity == nil {
+		return nil
+	}
+	return activity.LastSignInActivity.LastSuccessfulSignInDateTime
+}
+
+//// BETA MODELS
+
+// servicePrincipalSignInActivity is the beta servicePrincipalSignInActivity resource, which the v1.0 SDK has no model for
+type servicePrincipalSignInActivity struct {
+	Id                                              *string
+	AppId                                           *string
+	LastSignInActivity                              *signInActivityDetail
+	DelegatedClientSignInActivity                   *signInActivityDetail
+	DelegatedResourceSignInActivity                 *signInActivityDetail
+	ApplicationAuthenticationClientSignInActivity   *signInActivityDetail
+	ApplicationAuthenticationResourceSignInActivity *signInActivityDetail
+}
+
+// signInActivityDetail is the beta signInActivity type, with the last sign-in and the last successful sign-in of an application
+type signInActivityDetail struct {
+	LastSignInDateTime            *time.Time `json:"lastSignInDateTime,omitempty"`
+	LastSignInRequestId           *string    `json:"lastSignInRequestId,omitempty"`
+	LastSuccessfulSignInDateTime  *time.Time `json:"lastSuccessfulSignInDateTime,omitempty"`
+	LastSuccessfulSignInRequestId *string    `json:"lastSuccessfulSignInRequestId,omitempty"`
+}
+
+// servicePrincipalSignInActivityCollectionResponse is a page of sign-in activities, read by iteratePages
+type servicePrincipalSignInActivityCollectionResponse struct {
+	value         []*servicePrincipalSignInActivity
+	odataNextLink *string
+}
+
+func createServicePrincipalSignInActivityCollectionResponse(_ serialization.ParseNode) (serialization.Parsable, error) {
+	return &servicePrincipalSignInActivityCollectionResponse{}, nil
+}
+
+func createServicePrincipalSignInActivity(_ serialization.ParseNode) (serialization.Parsable, error) {
+	return &servicePrincipalSignInActivity{}, nil
+}
+
+func createSignInActivityDetail(_ serialization.ParseNode) (serialization.Parsable, error) {
+	return &signInActivityDetail{}, nil
+}
+
+func (response *servicePrincipalSignInActivityCollectionResponse) GetValue() []*servicePrincipalSignInActivity {
+	return response.value
+}
+
+func (response *servicePrincipalSignInActivityCollectionResponse) GetOdataNextLink() *string {
+	return response.odataNextLink
+}
+
+func (response *servicePrincipalSignInActivityCollectionResponse) GetFieldDeserializers() map[string]func(serialization.ParseNode) error {
+	return map[string]func(serialization.ParseNode) error{
+		"value": func(n serialization.ParseNode) error {
+			values, err := n.GetCollectionOfObjectValues(createServicePrincipalSignInActivity)
+			if err != nil {
+				return err
+			}
+			response.value = make([]*servicePrincipalSignInActivity, 0, len(values))
+			for _, v := range values {
+				if activity, ok := v.(*servicePrincipalSignInActivity); ok {
+					response.value = append(response.value, activity)
+				}
+			}
+			return nil
+		},
+		"@odata.nextLink": func(n serialization.ParseNode) error {
+			value, err := n.GetStringValue()
+			response.odataNextLink = value
+			return err
+		},
+	}
+}
+
+func (response *servicePrincipalSignInActivityCollectionResponse) Serialize(writer serialization.SerializationWriter) error {
+	values := make([]serialization.Parsable, 0, len(response.value))
+	for _, v := range response.value {
+		values = append(values, v)
+	}
+	if err := writer.WriteCollectionOfObjectValues("value", values); err != nil {
+		return err
+	}
+	return writer.WriteStringValue("@odata.nextLink", response.odataNextLink)
+}
+
+func (activity *servicePrincipalSignInActivity) GetFieldDeserializers() map[string]func(serialization.ParseNode) error {
+	detail := func(target **signInActivityDetail) func(serialization.ParseNode) error {
+		return func(n serialization.ParseNode) error {
+			value, err := n.GetObjectValue(createSignInActivityDetail)
+			if err != nil {
+				return err
+			}
+			if value != nil {
+				*target = value.(*signInActivityDetail)
+			}
+			return nil
+		}
+	}
+
+	return map[string]func(serialization.ParseNode) error{
+		"id": func(n serialization.ParseNode) error {
+			value, err := n.GetStringValue()
+			activity.Id = value
+			return err
+		},
+		"appId": func(n serialization.ParseNode) error {
+			value, err := n.GetStringValue()
+			activity.AppId = value
+			return err
+		},
+		"lastSignInActivity":                              detail(&activity.LastSignInActivity),
+		"delegatedClientSignInActivity":                   detail(&activity.DelegatedClientSignInActivity),
+		"delegatedResourceSignInActivity":                 detail(&activity.DelegatedResourceSignInActivity),
+		"applicationAuthenticationClientSignInActivity":   detail(&activity.ApplicationAuthenticationClientSignInActivity),
+		"applicationAuthenticationResourceSignInActivity": detail(&activity.ApplicationAuthenticationResourceSignInActivity),
+	}
+}
+
+func (activity *servicePrincipalSignInActivity) Serialize(writer serialization.SerializationWriter) error {
+	if err := writer.WriteStringValue("id", activity.Id); err != nil {
+		return err
+	}
+	if err := writer.WriteStringValue("appId", activity.AppId); err != nil {
+		return err
+	}
+
+	details := []struct {
+		key    string
+		detail *signInActivityDetail
+	}{
+		{"lastSignInActivity", activity.LastSignInActivity},
+		{"delegatedClientSignInActivity", activity.DelegatedClientSignInActivity},
+		{"delegatedResourceSignInActivity", activity.DelegatedResourceSignInActivity},
+		{"applicationAuthenticationClientSignInActivity", activity.ApplicationAuthenticationClientSignInActivity},
+		{"applicationAuthenticationResourceSignInActivity", activity.ApplicationAuthenticationResourceSignInActivity},
+	}
+	for _, d := range details {
+		if d.detail == nil {
+			continue
+		}
+		if err := writer.WriteObjectValue(d.key, d.detail); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (detail *signInActivityDetail) GetFieldDeserializers() map[string]func(serialization.ParseNode) error {
+	return map[string]func(serialization.ParseNode) error{
+		"lastSignInDateTime": func(n serialization.ParseNode) error {
+			value, err := n.GetTimeValue()
+			detail.LastSignInDateTime = value
+			return err
+		},
+		"lastSignInRequestId": func(n serialization.ParseNode) error {
+			value, err := n.GetStringValue()
+			detail.LastSignInRequestId = value
+			return err
+		},
+		"lastSuccessfulSignInDateTime": func(n serialization.ParseNode) error {
+			value, err := n.GetTimeValue()
+			detail.LastSuccessfulSignInDateTime = value
+			return err
+		},
+		"lastSuccessfulSignInRequestId": func(n serialization.ParseNode) error {
+			value, err := n.GetStringValue()
+			detail.LastSuccessfulSignInRequestId = value
+			return err
+		},
+	}
+}
+
+func (detail *signInActivityDetail) Serialize(writer serialization.SerializationWriter) error {
+	if err := writer.WriteTimeValue("lastSignInDateTime", detail.LastSignInDateTime); err != nil {
+		return err
+	}
+	if err := writer.WriteStringValue("lastSignInRequestId", detail.LastSignInRequestId); err != nil {
+		return err
+	}
+	if err := writer.WriteTimeValue("lastSuccessfulSignInDateTime", detail.LastSuccessfulSignInDateTime); err != nil {
+		return err
+	}
+	return writer.WriteStringValue("lastSuccessfulSignInRequestId", detail.LastSuccessfulSignInRequestId)
+}
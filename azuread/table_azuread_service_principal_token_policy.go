@@ -0,0 +1,122 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdServicePrincipalTokenPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_service_principal_token_policy",
+		Description: "Represents a claims mapping or token issuance policy assigned to a service principal, which customizes the claims and the tokens issued for the application.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdServicePrincipalTokenPolicies,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "sp_id", Require: plugin.Required},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "sp_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the service principal the policy is assigned to.", Transform: transform.FromField("ServicePrincipalId")},
+			{Name: "policy_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the policy.", Transform: transform.FromMethod("GetId")},
+			{Name: "policy_type", Type: proto.ColumnType_STRING, Description: "The type of the policy. Possible values are: claimsMappingPolicy, tokenIssuancePolicy.", Transform: transform.FromField("PolicyType")},
+			{Name: "policy_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the policy.", Transform: transform.FromMethod("GetDisplayName")},
+
+			// Other fields
+			{Name: "is_organization_default", Type: proto.ColumnType_BOOL, Description: "If set to true, activates this policy.", Transform: transform.FromMethod("GetIsOrganizationDefault")},
+
+			// JSON fields
+			{Name: "definition", Type: proto.ColumnType_JSON, Description: "A string collection containing a JSON string that defines the rules and settings of the policy.", Transform: transform.FromMethod("GetDefinition")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adServicePrincipalTokenPolicyTitle)},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdServicePrincipalTokenPolicies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	servicePrincipalId := d.EqualsQuals["sp_id"].GetStringValue()
+	if servicePrincipalId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_service_principal_token_policy.listAdServicePrincipalTokenPolicies", "connection_error", err)
+		return nil, err
+	}
+
+	// Claims mapping policies
+	claimsMappingPolicies, err := client.ServicePrincipals().ByServicePrincipalId(servicePrincipalId).ClaimsMappingPolicies().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdServicePrincipalTokenPolicies", "list_claims_mapping_policy_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, claimsMappingPolicies, models.CreateClaimsMappingPolicyCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.ClaimsMappingPolicyable) bool {
+		d.StreamListItem(ctx, &ADServicePrincipalTokenPolicyInfo{pageItem, &servicePrincipalId, "claimsMappingPolicy"})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdServicePrincipalTokenPolicies", "claims_mapping_paging_error", err)
+		return nil, err
+	}
+
+	// Context can be cancelled due to manual cancellation or the limit has been hit
+	if d.RowsRemaining(ctx) == 0 {
+		return nil, nil
+	}
+
+	// Token issuance policies
+	tokenIssuancePolicies, err := client.ServicePrincipals().ByServicePrincipalId(servicePrincipalId).TokenIssuancePolicies().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdServicePrincipalTokenPolicies", "list_token_issuance_policy_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, tokenIssuancePolicies, models.CreateTokenIssuancePolicyCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.TokenIssuancePolicyable) bool {
+		d.StreamListItem(ctx, &ADServicePrincipalTokenPolicyInfo{pageItem, &servicePrincipalId, "tokenIssuancePolicy"})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdServicePrincipalTokenPolicies", "token_issuance_paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func adServicePrincipalTokenPolicyTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADServicePrincipalTokenPolicyInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	title := data.GetDisplayName()
+	if title == nil {
+		title = data.GetId()
+	}
+
+	return title, nil
+}
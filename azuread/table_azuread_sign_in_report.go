@@ -3,7 +3,6 @@ package azuread
 import (
 	"context"
 
-	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
 	"github.com/microsoftgraph/msgraph-sdk-go/auditlogs"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 
@@ -55,6 +54,7 @@ func tableAzureAdSignInReport(_ context.Context) *plugin.Table {
 			{Name: "device_detail", Type: proto.ColumnType_JSON, Description: "Device information from where the sign-in occurred; includes device ID, operating system, and browser.", Transform: transform.FromMethod("SignInDeviceDetail")},
 			{Name: "location", Type: proto.ColumnType_JSON, Description: "Provides the city, state, and country code where the sign-in originated.", Transform: transform.FromMethod("SignInLocation")},
 			{Name: "applied_conditional_access_policies", Type: proto.ColumnType_JSON, Description: "Provides a list of conditional access policies that are triggered by the corresponding sign-in activity.", Transform: transform.FromMethod("SignInAppliedConditionalAccessPolicies")},
+			rawColumn(),
 
 			// Standard columns
 			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetId")},
@@ -98,13 +98,8 @@ func listAdSignInReports(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 		return nil, errObj
 	}
 
-	pageIterator, err := msgraphcore.NewPageIterator[interface{}](result, adapter, models.CreateSignInCollectionResponseFromDiscriminatorValue)
-	if err != nil {
-		plugin.Logger(ctx).Error("listAdSignInReports", "create_iterator_instance_error", err)
-		return nil, err
-	}
-
-	err = pageIterator.Iterate(ctx, func(pageItem interface{}) bool {
+	// A page which fails to be fetched is retried from its nextLink, so a large scan doesn't restart from the first page
+	err = iteratePages(ctx, d, adapter, result, models.CreateSignInCollectionResponseFromDiscriminatorValue, nil, func(pageItem interface{}) bool {
 		// To prevent errors during type conversion caused by inconsistent API responses (especially with larger data sets), we may get the different type of response (models.DirectoryAuditable), we need to include the following check.
 		if signIn, ok := pageItem.(models.SignInable); ok {
 			d.StreamListItem(ctx, &ADSignInReportInfo{signIn})
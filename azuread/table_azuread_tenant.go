@@ -0,0 +1,87 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/organization"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdTenant(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_tenant",
+		Description: "Represents the Azure AD tenant of the connection, as a single row.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdTenants,
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "tenant_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the tenant.", Transform: transform.FromMethod("GetId")},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name of the tenant.", Transform: transform.FromMethod("GetDisplayName")},
+			{Name: "default_domain", Type: proto.ColumnType_STRING, Description: "The default verified domain of the tenant.", Transform: transform.FromMethod("TenantDefaultDomain")},
+
+			// JSON fields
+			{Name: "marketing_notification_emails", Type: proto.ColumnType_JSON, Description: "The email addresses which receive the marketing notifications of the tenant.", Transform: transform.FromMethod("GetMarketingNotificationEmails")},
+			{Name: "security_compliance_notification_mails", Type: proto.ColumnType_JSON, Description: "The email addresses which receive the security and compliance notifications of the tenant, e.g. about a breach.", Transform: transform.FromMethod("GetSecurityComplianceNotificationMails")},
+			{Name: "security_compliance_notification_phones", Type: proto.ColumnType_JSON, Description: "The phone numbers which receive the security and compliance notifications of the tenant.", Transform: transform.FromMethod("GetSecurityComplianceNotificationPhones")},
+			{Name: "technical_notification_emails", Type: proto.ColumnType_JSON, Description: "The email addresses which receive the technical notifications of the tenant.", Transform: transform.FromMethod("GetTechnicalNotificationMails")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetDisplayName")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdTenants(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	tenant, err := getAdTenantOrganizationMemoized(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	if tenant != nil {
+		d.StreamListItem(ctx, tenant)
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// The tenant doesn't change during the lifetime of a connection, so it is fetched once
+var getAdTenantOrganizationMemoized = plugin.HydrateFunc(getAdTenantOrganizationUncached).Memoize()
+
+func getAdTenantOrganizationUncached(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_tenant.getAdTenantOrganizationUncached", "connection_error", err)
+		return nil, err
+	}
+
+	options := &organization.OrganizationRequestBuilderGetRequestConfiguration{
+		QueryParameters: &organization.OrganizationRequestBuilderGetQueryParameters{
+			Select: []string{"id", "displayName", "verifiedDomains", "marketingNotificationEmails", "securityComplianceNotificationMails", "securityComplianceNotificationPhones", "technicalNotificationMails"},
+		},
+	}
+
+	// The organization collection only contains the tenant of the signed-in identity
+	result, err := client.Organization().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getAdTenantOrganizationUncached", "list_organization_error", errObj)
+		return nil, errObj
+	}
+
+	if len(result.GetValue()) == 0 {
+		return nil, nil
+	}
+
+	return &ADTenantInfo{result.GetValue()[0]}, nil
+}
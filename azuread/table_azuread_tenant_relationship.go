@@ -0,0 +1,146 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/tenantrelationships"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdTenantRelationship(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_tenant_relationship",
+		Description: "Represents a delegated admin relationship between a partner tenant and a customer tenant.",
+		Get: &plugin.GetConfig{
+			Hydrate: getAdTenantRelationship,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAdTenantRelationships,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_UnsupportedQuery"}),
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the relationship.", Transform: transform.FromMethod("GetId")},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name of the relationship used for ease of identification.", Transform: transform.FromMethod("GetDisplayName")},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the relationship. Possible values are: activating, active, approvalPending, approved, created, expired, expiring, terminated, terminating, terminationRequested, unknownFutureValue.", Transform: transform.FromMethod("TenantRelationshipStatus")},
+			{Name: "customer_tenant_id", Type: proto.ColumnType_STRING, Description: "The Microsoft Entra ID-assigned tenant ID of the customer tenant.", Transform: transform.FromMethod("TenantRelationshipCustomerTenantId")},
+			{Name: "customer_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the customer tenant.", Transform: transform.FromMethod("TenantRelationshipCustomerDisplayName")},
+
+			// Other fields
+			{Name: "activated_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time in ISO 8601 format and in UTC time when the relationship became active.", Transform: transform.FromMethod("GetActivatedDateTime")},
+			{Name: "auto_extend_duration", Type: proto.ColumnType_STRING, Description: "The duration by which the validity of the relationship is automatically extended, denoted in ISO 8601 format.", Transform: transform.FromMethod("TenantRelationshipAutoExtendDuration")},
+			{Name: "created_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time in ISO 8601 format and in UTC time when the relationship was created.", Transform: transform.FromMethod("GetCreatedDateTime")},
+			{Name: "duration", Type: proto.ColumnType_STRING, Description: "The duration of the relationship in ISO 8601 format.", Transform: transform.FromMethod("TenantRelationshipDuration")},
+			{Name: "end_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time in ISO 8601 format and in UTC time when the status of relationship changes to either terminated or expired.", Transform: transform.FromMethod("GetEndDateTime")},
+			{Name: "last_modified_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time in ISO 8601 format and in UTC time when the relationship was last modified.", Transform: transform.FromMethod("GetLastModifiedDateTime")},
+
+			// JSON fields
+			{Name: "access_details", Type: proto.ColumnType_JSON, Description: "The access details of the relationship, including the identifiers of the administrative roles that the partner admin is assigned in the customer tenant.", Transform: transform.FromMethod("TenantRelationshipAccessDetails")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adTenantRelationshipTitle)},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdTenantRelationships(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_tenant_relationship.listAdTenantRelationships", "connection_error", err)
+		return nil, err
+	}
+
+	// List operations
+	input := &tenantrelationships.DelegatedAdminRelationshipsRequestBuilderGetQueryParameters{
+		Top: Int32(300),
+	}
+
+	// The delegated admin relationships API supports a maximum page size of 300
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 300 {
+			l := int32(*limit)
+			input.Top = Int32(l)
+		}
+	}
+
+	options := &tenantrelationships.DelegatedAdminRelationshipsRequestBuilderGetRequestConfiguration{
+		QueryParameters: input,
+	}
+
+	result, err := client.TenantRelationships().DelegatedAdminRelationships().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdTenantRelationships", "list_tenant_relationship_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateDelegatedAdminRelationshipCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.DelegatedAdminRelationshipable) bool {
+		d.StreamListItem(ctx, &ADTenantRelationshipInfo{pageItem})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdTenantRelationships", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAdTenantRelationship(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	relationshipId := d.EqualsQuals["id"].GetStringValue()
+	if relationshipId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_tenant_relationship.getAdTenantRelationship", "connection_error", err)
+		return nil, err
+	}
+
+	relationship, err := client.TenantRelationships().DelegatedAdminRelationships().ByDelegatedAdminRelationshipId(relationshipId).Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getAdTenantRelationship", "get_tenant_relationship_error", errObj)
+		return nil, errObj
+	}
+
+	return &ADTenantRelationshipInfo{relationship}, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func adTenantRelationshipTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADTenantRelationshipInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	title := data.GetDisplayName()
+	if title == nil {
+		title = data.GetId()
+	}
+
+	return title, nil
+}
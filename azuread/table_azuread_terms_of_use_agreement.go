@@ -0,0 +1,123 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdTermsOfUseAgreement(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_terms_of_use_agreement",
+		Description: "Represents a tenant's customizable terms of use agreement that is created and managed with Azure AD Identity Governance.",
+		Get: &plugin.GetConfig{
+			Hydrate: getAdTermsOfUseAgreement,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier", "AadPremiumLicenseRequired"}),
+			},
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAdTermsOfUseAgreements,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				// Terms of use requires an Azure AD Premium license, tenants without it can't use the API
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"AadPremiumLicenseRequired"}),
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the agreement.", Transform: transform.FromMethod("GetId")},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "Display name of the agreement. The display name is used for internal tracking of the agreement but isn't shown to end users who view the agreement.", Transform: transform.FromMethod("GetDisplayName")},
+
+			// Other fields
+			{Name: "is_viewing_before_acceptance_required", Type: proto.ColumnType_BOOL, Description: "Indicates whether the user has to expand the agreement before accepting.", Transform: transform.FromMethod("GetIsViewingBeforeAcceptanceRequired")},
+			{Name: "is_per_device_acceptance_required", Type: proto.ColumnType_BOOL, Description: "Indicates whether end users are required to accept this agreement on every device that they access it from.", Transform: transform.FromMethod("GetIsPerDeviceAcceptanceRequired")},
+			{Name: "user_reacceptance_required_frequency", Type: proto.ColumnType_STRING, Description: "The duration after which the user must reaccept the terms of use, denoted in ISO 8601 format.", Transform: transform.FromMethod("TermsOfUseAgreementUserReacceptRequiredFrequency")},
+
+			// JSON fields
+			{Name: "terms_expiration", Type: proto.ColumnType_JSON, Description: "Expiration schedule and frequency of agreement for all users.", Transform: transform.FromMethod("TermsOfUseAgreementTermsExpiration")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adTermsOfUseAgreementTitle)},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdTermsOfUseAgreements(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_terms_of_use_agreement.listAdTermsOfUseAgreements", "connection_error", err)
+		return nil, err
+	}
+
+	// The agreements API doesn't support the $top query parameter, so the page size is left to the service
+	result, err := client.IdentityGovernance().TermsOfUse().Agreements().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdTermsOfUseAgreements", "list_terms_of_use_agreement_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateAgreementCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.Agreementable) bool {
+		d.StreamListItem(ctx, &ADTermsOfUseAgreementInfo{pageItem})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdTermsOfUseAgreements", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAdTermsOfUseAgreement(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	agreementId := d.EqualsQuals["id"].GetStringValue()
+	if agreementId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_terms_of_use_agreement.getAdTermsOfUseAgreement", "connection_error", err)
+		return nil, err
+	}
+
+	agreement, err := client.IdentityGovernance().TermsOfUse().Agreements().ByAgreementId(agreementId).Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getAdTermsOfUseAgreement", "get_terms_of_use_agreement_error", errObj)
+		return nil, errObj
+	}
+
+	return &ADTermsOfUseAgreementInfo{agreement}, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func adTermsOfUseAgreementTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADTermsOfUseAgreementInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	title := data.GetDisplayName()
+	if title == nil {
+		title = data.GetId()
+	}
+
+	return title, nil
+}
@@ -0,0 +1,76 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdTrustedCertificateAuthority(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_trusted_certificate_authority",
+		Description: "Represents a certificate authority trusted by the tenant to issue the user certificates used for certificate-based authentication.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdTrustedCertificateAuthorities,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound"}),
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the certificate-based authentication configuration the certificate authority belongs to.", Transform: transform.FromField("CertificateBasedAuthConfigurationId")},
+			{Name: "issuer", Type: proto.ColumnType_STRING, Description: "The issuer of the certificate, calculated from the certificate value.", Transform: transform.FromMethod("GetIssuer")},
+			{Name: "issuer_ski", Type: proto.ColumnType_STRING, Description: "The subject key identifier of the certificate, calculated from the certificate value.", Transform: transform.FromMethod("GetIssuerSki")},
+			{Name: "is_root_authority", Type: proto.ColumnType_BOOL, Description: "True if the trusted certificate is a root authority, false if the trusted certificate is an intermediate authority.", Transform: transform.FromMethod("GetIsRootAuthority")},
+
+			// Other fields
+			{Name: "certificate_revocation_list_url", Type: proto.ColumnType_STRING, Description: "The URL of the certificate revocation list.", Transform: transform.FromMethod("GetCertificateRevocationListUrl")},
+			{Name: "delta_certificate_revocation_list_url", Type: proto.ColumnType_STRING, Description: "The URL of the delta certificate revocation list, which contains all revoked certificates since the last time a full certificate revocation list was created.", Transform: transform.FromMethod("GetDeltaCertificateRevocationListUrl")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("GetIssuer")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdTrustedCertificateAuthorities(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	tenantId, err := getTenant(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_trusted_certificate_authority.listAdTrustedCertificateAuthorities", "get_tenant_error", err)
+		return nil, err
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_trusted_certificate_authority.listAdTrustedCertificateAuthorities", "connection_error", err)
+		return nil, err
+	}
+
+	result, err := client.Organization().ByOrganizationId(tenantId.(string)).CertificateBasedAuthConfiguration().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdTrustedCertificateAuthorities", "list_certificate_based_auth_configuration_error", errObj)
+		return nil, errObj
+	}
+
+	// The list is empty when certificate-based authentication isn't configured
+	for _, configuration := range result.GetValue() {
+		for _, certificateAuthority := range configuration.GetCertificateAuthorities() {
+			d.StreamListItem(ctx, &ADCertificateAuthorityInfo{certificateAuthority, configuration.GetId()})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -6,7 +6,7 @@ import (
 	"strings"
 
 	"github.com/iancoleman/strcase"
-	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
+	abstractions "github.com/microsoft/kiota-abstractions-go"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 	"github.com/microsoftgraph/msgraph-sdk-go/users"
 
@@ -39,11 +39,20 @@ func tableAzureAdUser(_ context.Context) *plugin.Table {
 				// Other fields for filtering OData
 				{Name: "user_type", Require: plugin.Optional},
 				{Name: "account_enabled", Require: plugin.Optional, Operators: []string{"<>", "="}},
-				{Name: "display_name", Require: plugin.Optional},
+				{Name: "display_name", Require: plugin.Optional, Operators: []string{"=", "~~", "~~*"}},
 				{Name: "surname", Require: plugin.Optional},
 			},
 		},
 
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getAdUserHasPhoto,
+				IgnoreConfig: &plugin.IgnoreConfig{
+					ShouldIgnoreErrorFunc: isIgnorableErrorPredicate(photoIgnorableErrors),
+				},
+			},
+		},
+
 		Columns: commonColumns([]*plugin.Column{
 			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The name displayed in the address book for the user. This is usually the combination of the user's first name, middle initial and last name.", Transform: transform.FromMethod("GetDisplayName")},
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier for the user. Should be treated as an opaque identifier.", Transform: transform.FromMethod("GetId")},
@@ -58,18 +67,50 @@ func tableAzureAdUser(_ context.Context) *plugin.Table {
 
 			// Other fields
 			{Name: "on_premises_immutable_id", Type: proto.ColumnType_STRING, Description: "Used to associate an on-premises Active Directory user account with their Azure AD user object.", Transform: transform.FromMethod("GetOnPremisesImmutableId")},
+			{Name: "on_premises_last_sync_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "Indicates the last time at which the object was synced with the on-premises directory.", Transform: transform.FromMethod("GetOnPremisesLastSyncDateTime")},
+			{Name: "on_premises_sam_account_name", Type: proto.ColumnType_STRING, Description: "Contains the on-premises SAM account name synchronized from the on-premises directory.", Transform: transform.FromMethod("GetOnPremisesSamAccountName")},
+			{Name: "on_premises_security_identifier", Type: proto.ColumnType_STRING, Description: "Contains the on-premises security identifier (SID) for the user that was synchronized from on-premises to the cloud.", Transform: transform.FromMethod("GetOnPremisesSecurityIdentifier")},
+			{Name: "on_premises_sync_enabled", Type: proto.ColumnType_BOOL, Description: "True if this user object is currently being synced from an on-premises Active Directory; false if the user was originally synced from an on-premises Active Directory but is no longer synced; null if this object has never been synced from an on-premises directory (default).", Transform: transform.FromMethod("GetOnPremisesSyncEnabled")},
 			{Name: "created_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time at which the user was created.", Transform: transform.FromMethod("GetCreatedDateTime")},
 			{Name: "mail", Type: proto.ColumnType_STRING, Description: "The SMTP address for the user, for example, jeff@contoso.onmicrosoft.com.", Transform: transform.FromMethod("GetMail")},
 			{Name: "mail_nickname", Type: proto.ColumnType_STRING, Description: "The mail alias for the user.", Transform: transform.FromMethod("GetMailNickname")},
 			{Name: "password_policies", Type: proto.ColumnType_STRING, Description: "Specifies password policies for the user. This value is an enumeration with one possible value being DisableStrongPassword, which allows weaker passwords than the default policy to be specified. DisablePasswordExpiration can also be specified. The two may be specified together; for example: DisablePasswordExpiration, DisableStrongPassword.", Transform: transform.FromMethod("GetPasswordPolicies")},
 			{Name: "sign_in_sessions_valid_from_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "Any refresh tokens or sessions tokens (session cookies) issued before this time are invalid, and applications will get an error when using an invalid refresh or sessions token to acquire a delegated access token (to access APIs such as Microsoft Graph).", Transform: transform.FromMethod("GetSignInSessionsValidFromDateTime")},
 			{Name: "usage_location", Type: proto.ColumnType_STRING, Description: "A two letter country code (ISO standard 3166), required for users that will be assigned licenses due to legal requirement to check for availability of services in countries.", Transform: transform.FromMethod("GetUsageLocation")},
+			{Name: "age_group", Type: proto.ColumnType_STRING, Description: "The age group of the user, for the parental controls. Possible values are: null, Minor, NotAdult, Adult.", Transform: transform.FromMethod("GetAgeGroup")},
+			{Name: "company_name", Type: proto.ColumnType_STRING, Description: "The name of the company the user is associated with, e.g. the company of an external user or of a subsidiary.", Transform: transform.FromMethod("GetCompanyName")},
+			{Name: "employee_hire_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the user was hired or will start work in case of a future hire.", Transform: transform.FromMethod("GetEmployeeHireDate")},
+			{Name: "employee_id", Type: proto.ColumnType_STRING, Description: "The employee identifier assigned to the user by the organization.", Transform: transform.FromMethod("GetEmployeeId")},
+			{Name: "employee_leave_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the user left or will leave the organization.", Transform: transform.FromMethod("GetEmployeeLeaveDateTime")},
+			{Name: "employee_type", Type: proto.ColumnType_STRING, Description: "The enterprise worker type, e.g. Employee, Contractor, Consultant or Vendor.", Transform: transform.FromMethod("GetEmployeeType")},
+			{Name: "preferred_language", Type: proto.ColumnType_STRING, Description: "The preferred language of the user, in the ISO 639-1 format, e.g. en-US.", Transform: transform.FromMethod("GetPreferredLanguage")},
+			{Name: "has_photo", Type: proto.ColumnType_BOOL, Description: "True if the user has a profile photo. Null if the photo can't be read, e.g. for a user without a mailbox.", Hydrate: getAdUserHasPhoto, Transform: transform.FromValue()},
+			{Name: "office_location", Type: proto.ColumnType_STRING, Description: "The office location in the place of business of the user.", Transform: transform.FromMethod("GetOfficeLocation")},
+			{Name: "extension_attribute_1", Type: proto.ColumnType_STRING, Description: "The extension attribute 1 of the user, from the on_premises_extension_attributes.", Transform: transform.From(adUserExtensionAttribute)},
+			{Name: "extension_attribute_2", Type: proto.ColumnType_STRING, Description: "The extension attribute 2 of the user, from the on_premises_extension_attributes.", Transform: transform.From(adUserExtensionAttribute)},
+			{Name: "extension_attribute_3", Type: proto.ColumnType_STRING, Description: "The extension attribute 3 of the user, from the on_premises_extension_attributes.", Transform: transform.From(adUserExtensionAttribute)},
+			{Name: "extension_attribute_4", Type: proto.ColumnType_STRING, Description: "The extension attribute 4 of the user, from the on_premises_extension_attributes.", Transform: transform.From(adUserExtensionAttribute)},
+			{Name: "extension_attribute_5", Type: proto.ColumnType_STRING, Description: "The extension attribute 5 of the user, from the on_premises_extension_attributes.", Transform: transform.From(adUserExtensionAttribute)},
+			{Name: "extension_attribute_6", Type: proto.ColumnType_STRING, Description: "The extension attribute 6 of the user, from the on_premises_extension_attributes.", Transform: transform.From(adUserExtensionAttribute)},
+			{Name: "extension_attribute_7", Type: proto.ColumnType_STRING, Description: "The extension attribute 7 of the user, from the on_premises_extension_attributes.", Transform: transform.From(adUserExtensionAttribute)},
+			{Name: "extension_attribute_8", Type: proto.ColumnType_STRING, Description: "The extension attribute 8 of the user, from the on_premises_extension_attributes.", Transform: transform.From(adUserExtensionAttribute)},
+			{Name: "extension_attribute_9", Type: proto.ColumnType_STRING, Description: "The extension attribute 9 of the user, from the on_premises_extension_attributes.", Transform: transform.From(adUserExtensionAttribute)},
+			{Name: "extension_attribute_10", Type: proto.ColumnType_STRING, Description: "The extension attribute 10 of the user, from the on_premises_extension_attributes.", Transform: transform.From(adUserExtensionAttribute)},
+			{Name: "extension_attribute_11", Type: proto.ColumnType_STRING, Description: "The extension attribute 11 of the user, from the on_premises_extension_attributes.", Transform: transform.From(adUserExtensionAttribute)},
+			{Name: "extension_attribute_12", Type: proto.ColumnType_STRING, Description: "The extension attribute 12 of the user, from the on_premises_extension_attributes.", Transform: transform.From(adUserExtensionAttribute)},
+			{Name: "extension_attribute_13", Type: proto.ColumnType_STRING, Description: "The extension attribute 13 of the user, from the on_premises_extension_attributes.", Transform: transform.From(adUserExtensionAttribute)},
+			{Name: "extension_attribute_14", Type: proto.ColumnType_STRING, Description: "The extension attribute 14 of the user, from the on_premises_extension_attributes.", Transform: transform.From(adUserExtensionAttribute)},
+			{Name: "extension_attribute_15", Type: proto.ColumnType_STRING, Description: "The extension attribute 15 of the user, from the on_premises_extension_attributes.", Transform: transform.From(adUserExtensionAttribute)},
 
 			// Json fields
 			{Name: "member_of", Type: proto.ColumnType_JSON, Description: "A list the groups and directory roles that the user is a direct member of.", Transform: transform.FromMethod("UserMemberOf")},
 			{Name: "im_addresses", Type: proto.ColumnType_JSON, Description: "The instant message voice over IP (VOIP) session initiation protocol (SIP) addresses for the user.", Transform: transform.FromMethod("GetImAddresses")},
 			{Name: "other_mails", Type: proto.ColumnType_JSON, Description: "A list of additional email addresses for the user.", Transform: transform.FromMethod("GetOtherMails")},
+			{Name: "proxy_addresses", Type: proto.ColumnType_JSON, Description: "The email addresses of the user, e.g. [\"SMTP: bob@contoso.com\", \"smtp: bob@sales.contoso.com\"]. The address prefixed by SMTP in uppercase is the primary one.", Transform: transform.FromMethod("GetProxyAddresses")},
+			{Name: "on_premises_extension_attributes", Type: proto.ColumnType_JSON, Description: "The extension attributes 1-15 of the user, synchronized from the on-premises Active Directory or set directly for a cloud-only user.", Transform: transform.FromMethod("UserOnPremisesExtensionAttributes")},
 			{Name: "password_profile", Type: proto.ColumnType_JSON, Description: "Specifies the password profile for the user. The profile contains the user’s password. This property is required when a user is created.", Transform: transform.FromMethod("UserPasswordProfile")},
+			{Name: "custom_security_attributes", Type: proto.ColumnType_JSON, Description: "The custom security attributes assigned to the user, by attribute set, e.g. {\"Engineering\": {\"Project\": \"Baker\"}}. Requires the Attribute Assignment Reader role.", Transform: transform.From(adUserCustomSecurityAttributes)},
+			rawColumn(),
 
 			// Standard columns
 			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adUserTitle)},
@@ -106,7 +147,7 @@ func listAdUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData
 	quals := d.Quals
 
 	// Check for query context and requests only for queried columns
-	givenColumns := d.QueryContext.Columns
+	givenColumns := userRequestColumns(d)
 	selectColumns, expandColumns := buildUserRequestFields(ctx, givenColumns)
 
 	input.Select = selectColumns
@@ -127,8 +168,16 @@ func listAdUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData
 		input.Filter = &joinStr
 	}
 
+	// A like or ilike qual on display_name is sent to Graph as a $search, which requires the ConsistencyLevel header
+	headers := &abstractions.RequestHeaders{}
+	if search := buildDisplayNameSearch(quals); search != "" {
+		input.Search = &search
+		headers.Add("ConsistencyLevel", "eventual")
+	}
+
 	options := &users.UsersRequestBuilderGetRequestConfiguration{
 		QueryParameters: input,
+		Headers:         headers,
 	}
 
 	result, err := client.Users().Get(ctx, options)
@@ -138,13 +187,9 @@ func listAdUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData
 		return nil, errObj
 	}
 
-	pageIterator, err := msgraphcore.NewPageIterator[models.Userable](result, adapter, models.CreateUserCollectionResponseFromDiscriminatorValue)
-	if err != nil {
-		plugin.Logger(ctx).Error("listAdUsers", "create_iterator_instance_error", err)
-		return nil, err
-	}
-
-	err = pageIterator.Iterate(ctx, func(pageItem models.Userable) bool {
+	// The next page requests must carry the same headers as the first one.
+	// A page which fails to be fetched is retried from its nextLink, so a large scan doesn't restart from the first page.
+	err = iteratePages(ctx, d, adapter, result, models.CreateUserCollectionResponseFromDiscriminatorValue, headers, func(pageItem models.Userable) bool {
 		refreshTokensValidFromDateTime := pageItem.GetAdditionalData()["refreshTokensValidFromDateTime"]
 
 		d.StreamListItem(ctx, &ADUserInfo{pageItem, refreshTokensValidFromDateTime})
@@ -177,7 +222,7 @@ func getAdUser(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 	}
 
 	// Check for query context and requests only for queried columns
-	givenColumns := d.QueryContext.Columns
+	givenColumns := userRequestColumns(d)
 	selectColumns, expandColumns := buildUserRequestFields(ctx, givenColumns)
 
 	input := &users.UserItemRequestBuilderGetQueryParameters{}
@@ -199,11 +244,72 @@ func getAdUser(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 	return &ADUserInfo{user, refreshTokensValidFromDateTime}, nil
 }
 
+func getAdUserHasPhoto(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	user := h.Item.(*ADUserInfo)
+	if user.GetId() == nil {
+		return nil, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_user.getAdUserHasPhoto", "connection_error", err)
+		return nil, err
+	}
+
+	// Only the metadata of the photo is read, not its content
+	_, err = client.Users().ByUserId(*user.GetId()).Photo().Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		if isPhotoNotFoundError(errObj) {
+			return false, nil
+		}
+		plugin.Logger(ctx).Error("getAdUserHasPhoto", "get_photo_error", errObj)
+		return nil, errObj
+	}
+
+	return true, nil
+}
+
+// userRequestColumns returns the columns whose properties are requested. Graph only returns a few properties of a user unless they are selected,
+// so the properties of all the columns of the table are requested for the raw column, the custom security attributes aside as they require a role.
+func userRequestColumns(d *plugin.QueryData) []string {
+	queryColumns := d.QueryContext.Columns
+	if !isRawRequested(d) {
+		return queryColumns
+	}
+
+	columns := []string{}
+	for _, column := range d.Table.Columns {
+		if column.Name == "custom_security_attributes" && !helpers.StringSliceContains(queryColumns, column.Name) {
+			continue
+		}
+		columns = append(columns, column.Name)
+	}
+	return columns
+}
+
 func buildUserRequestFields(ctx context.Context, queryColumns []string) ([]string, []string) {
 	var selectColumns, expandColumns []string
 
 	for _, columnName := range queryColumns {
-		if columnName == "filter" || columnName == "tenant_id" {
+		if columnName == "filter" || columnName == "tenant_id" || columnName == "raw" {
+			continue
+		}
+
+		// The photo is read by a separate hydrate, which requires the id of the user
+		if columnName == "has_photo" {
+			if !helpers.StringSliceContains(queryColumns, "id") {
+				selectColumns = append(selectColumns, "id")
+			}
+			continue
+		}
+
+		// The flattened extension attributes are read from the onPremisesExtensionAttributes property
+		if strings.HasPrefix(columnName, "extension_attribute_") {
+			if !helpers.StringSliceContains(selectColumns, "onPremisesExtensionAttributes") && !helpers.StringSliceContains(queryColumns, "on_premises_extension_attributes") {
+				selectColumns = append(selectColumns, "onPremisesExtensionAttributes")
+			}
 			continue
 		}
 
@@ -230,6 +336,11 @@ func buildUserRequestFields(ctx context.Context, queryColumns []string) ([]strin
 
 //// TRANSFORM FUNCTIONS
 
+func adUserCustomSecurityAttributes(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	user := d.HydrateItem.(*ADUserInfo)
+	return customSecurityAttributeValues(ctx, user.GetCustomSecurityAttributes()), nil
+}
+
 func adUserTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	data := d.HydrateItem.(*ADUserInfo)
 	if data == nil {
@@ -244,6 +355,21 @@ func adUserTitle(_ context.Context, d *transform.TransformData) (interface{}, er
 	return title, nil
 }
 
+func adUserExtensionAttribute(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADUserInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	// The columns are named after the attributes, e.g. extension_attribute_1 for extensionAttribute1
+	attributes := data.UserOnPremisesExtensionAttributes()
+	if attributes == nil {
+		return nil, nil
+	}
+
+	return attributes[strcase.ToLowerCamel(d.ColumnName)], nil
+}
+
 func buildQueryFilter(equalQuals plugin.KeyColumnEqualsQualMap) []string {
 	filters := []string{}
 
@@ -263,7 +389,7 @@ func buildQueryFilter(equalQuals plugin.KeyColumnEqualsQualMap) []string {
 		switch qualType {
 		case "string":
 			if equalQuals[qual] != nil {
-				filters = append(filters, fmt.Sprintf("%s eq '%s'", strcase.ToCamel(qual), equalQuals[qual].GetStringValue()))
+				filters = append(filters, fmt.Sprintf("%s eq '%s'", strcase.ToCamel(qual), escapeODataString(equalQuals[qual].GetStringValue())))
 			}
 		case "bool":
 			if equalQuals[qual] != nil {
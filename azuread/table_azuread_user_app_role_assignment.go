@@ -7,7 +7,6 @@ import (
 
 	"github.com/iancoleman/strcase"
 	abstractions "github.com/microsoft/kiota-abstractions-go"
-	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 	"github.com/microsoftgraph/msgraph-sdk-go/users"
 
@@ -118,13 +117,7 @@ func listAdUserAppRoleAssignments(ctx context.Context, d *plugin.QueryData, _ *p
 		return nil, errObj
 	}
 
-	pageIterator, err := msgraphcore.NewPageIterator[models.AppRoleAssignmentable](result, adapter, models.CreateAppRoleAssignmentCollectionResponseFromDiscriminatorValue)
-	if err != nil {
-		plugin.Logger(ctx).Error("listAdUserAppRoleAssignments", "create_iterator_instance_error", err)
-		return nil, err
-	}
-
-	err = pageIterator.Iterate(ctx, func(pageItem models.AppRoleAssignmentable) bool {
+	err = iteratePages(ctx, d, adapter, result, models.CreateAppRoleAssignmentCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.AppRoleAssignmentable) bool {
 		d.StreamListItem(ctx, &ADUserAppRoleAssignmentInfo{pageItem, &userId})
 
 		// Context can be cancelled due to manual cancellation or the limit has been hit
@@ -0,0 +1,128 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/users"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdUserOwnedDevice(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_user_owned_device",
+		Description: "Represents a device that is owned by an Azure AD user.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdUserOwnedDevices,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "user_id", Require: plugin.Required},
+			},
+		},
+
+		Columns: commonColumns(userDeviceColumns()),
+	}
+}
+
+// userDeviceColumns returns the columns shared by the azuread_user_owned_device and azuread_user_registered_device tables
+func userDeviceColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{Name: "user_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the user.", Transform: transform.FromField("UserId")},
+		{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the device object.", Transform: transform.FromMethod("GetId")},
+		{Name: "device_id", Type: proto.ColumnType_STRING, Description: "Unique identifier set by Azure Device Registration Service at the time of registration.", Transform: transform.FromMethod("GetDeviceId")},
+		{Name: "device_display_name", Type: proto.ColumnType_STRING, Description: "The name displayed for the device.", Transform: transform.FromMethod("GetDisplayName")},
+
+		// Other fields
+		{Name: "account_enabled", Type: proto.ColumnType_BOOL, Description: "True if the account is enabled; otherwise, false.", Transform: transform.FromMethod("GetAccountEnabled")},
+		{Name: "approximate_last_sign_in_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The timestamp type represents date and time information using ISO 8601 format and is always in UTC time.", Transform: transform.FromMethod("GetApproximateLastSignInDateTime")},
+		{Name: "is_compliant", Type: proto.ColumnType_BOOL, Description: "True if the device is compliant; otherwise, false.", Transform: transform.FromMethod("GetIsCompliant")},
+		{Name: "is_managed", Type: proto.ColumnType_BOOL, Description: "True if the device is managed; otherwise, false.", Transform: transform.FromMethod("GetIsManaged")},
+		{Name: "operating_system", Type: proto.ColumnType_STRING, Description: "The type of operating system on the device.", Transform: transform.FromMethod("GetOperatingSystem")},
+		{Name: "operating_system_version", Type: proto.ColumnType_STRING, Description: "The version of the operating system on the device.", Transform: transform.FromMethod("GetOperatingSystemVersion")},
+		{Name: "trust_type", Type: proto.ColumnType_STRING, Description: "Type of trust for the joined device. Possible values: Workplace (indicates bring your own personal devices), AzureAd (Cloud only joined devices), ServerAd (on-premises domain joined devices joined to Azure AD).", Transform: transform.FromMethod("GetTrustType")},
+
+		// Standard columns
+		{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adUserDeviceTitle)},
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdUserOwnedDevices(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	userId := d.EqualsQuals["user_id"].GetStringValue()
+	if userId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_user_owned_device.listAdUserOwnedDevices", "connection_error", err)
+		return nil, err
+	}
+
+	// List operations
+	input := &users.ItemOwnedDevicesRequestBuilderGetQueryParameters{
+		Top: Int32(999),
+	}
+
+	// Restrict the limit value to be passed in the query parameter which is not between 1 and 999, otherwise API will throw an error as follow
+	// unexpected status 400 with OData error: Request_UnsupportedQuery: Invalid page size specified: '1000'. Must be between 1 and 999 inclusive.
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 999 {
+			l := int32(*limit)
+			input.Top = Int32(l)
+		}
+	}
+
+	options := &users.ItemOwnedDevicesRequestBuilderGetRequestConfiguration{
+		QueryParameters: input,
+	}
+
+	result, err := client.Users().ByUserId(userId).OwnedDevices().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdUserOwnedDevices", "list_user_owned_device_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateDirectoryObjectCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.DirectoryObjectable) bool {
+		// Owned objects are returned as directory objects, only devices are of interest here
+		if device, ok := pageItem.(models.Deviceable); ok {
+			d.StreamListItem(ctx, &ADUserDeviceInfo{device, &userId})
+		}
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdUserOwnedDevices", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func adUserDeviceTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADUserDeviceInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	title := data.GetDisplayName()
+	if title == nil {
+		title = data.GetId()
+	}
+
+	return title, nil
+}
@@ -0,0 +1,124 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdUserOwnedObject(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_user_owned_object",
+		Description: "Represents a directory object owned or created by a user, such as an application registration, a service principal or a group.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdUserOwnedObjects,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "user_id", Require: plugin.Required},
+				{Name: "relationship", Require: plugin.Optional},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "user_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the user.", Transform: transform.FromField("UserId")},
+			{Name: "object_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the object.", Transform: transform.FromMethod("GetId")},
+			{Name: "object_type", Type: proto.ColumnType_STRING, Description: "The type of the object, e.g. application, servicePrincipal or group.", Transform: transform.FromMethod("DirectoryObjectType")},
+			{Name: "object_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the object.", Transform: transform.FromMethod("DirectoryObjectDisplayName")},
+			{Name: "relationship", Type: proto.ColumnType_STRING, Description: "The relationship of the user to the object. Possible values are: owned, created.", Transform: transform.FromField("Relationship")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.From(adUserOwnedObjectTitle)},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdUserOwnedObjects(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	userId := d.EqualsQuals["user_id"].GetStringValue()
+	if userId == "" {
+		return nil, nil
+	}
+
+	relationship := ""
+	if d.EqualsQuals["relationship"] != nil {
+		relationship = d.EqualsQuals["relationship"].GetStringValue()
+	}
+
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_user_owned_object.listAdUserOwnedObjects", "connection_error", err)
+		return nil, err
+	}
+
+	if relationship == "" || relationship == "owned" {
+		result, err := client.Users().ByUserId(userId).OwnedObjects().Get(ctx, nil)
+		if err != nil {
+			errObj := getErrorObject(err)
+			plugin.Logger(ctx).Error("listAdUserOwnedObjects", "list_user_owned_object_error", errObj)
+			return nil, errObj
+		}
+
+		err = iteratePages(ctx, d, adapter, result, models.CreateDirectoryObjectCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.DirectoryObjectable) bool {
+			d.StreamListItem(ctx, &ADUserOwnedObjectInfo{ADDirectoryObjectInfo{pageItem}, &userId, "owned"})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			return d.RowsRemaining(ctx) != 0
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("listAdUserOwnedObjects", "paging_error", err)
+			return nil, err
+		}
+
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	if relationship == "" || relationship == "created" {
+		result, err := client.Users().ByUserId(userId).CreatedObjects().Get(ctx, nil)
+		if err != nil {
+			errObj := getErrorObject(err)
+			plugin.Logger(ctx).Error("listAdUserOwnedObjects", "list_user_created_object_error", errObj)
+			return nil, errObj
+		}
+
+		err = iteratePages(ctx, d, adapter, result, models.CreateDirectoryObjectCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.DirectoryObjectable) bool {
+			d.StreamListItem(ctx, &ADUserOwnedObjectInfo{ADDirectoryObjectInfo{pageItem}, &userId, "created"})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			return d.RowsRemaining(ctx) != 0
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("listAdUserOwnedObjects", "paging_error", err)
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func adUserOwnedObjectTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*ADUserOwnedObjectInfo)
+	if data == nil {
+		return nil, nil
+	}
+
+	title := data.DirectoryObjectDisplayName()
+	if title == nil {
+		title = data.GetId()
+	}
+
+	return title, nil
+}
@@ -0,0 +1,88 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/users"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdUserRegisteredDevice(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_user_registered_device",
+		Description: "Represents a device that is registered for an Azure AD user.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdUserRegisteredDevices,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "user_id", Require: plugin.Required},
+			},
+		},
+
+		Columns: commonColumns(userDeviceColumns()),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdUserRegisteredDevices(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	userId := d.EqualsQuals["user_id"].GetStringValue()
+	if userId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_user_registered_device.listAdUserRegisteredDevices", "connection_error", err)
+		return nil, err
+	}
+
+	// List operations
+	input := &users.ItemRegisteredDevicesRequestBuilderGetQueryParameters{
+		Top: Int32(999),
+	}
+
+	// Restrict the limit value to be passed in the query parameter which is not between 1 and 999, otherwise API will throw an error as follow
+	// unexpected status 400 with OData error: Request_UnsupportedQuery: Invalid page size specified: '1000'. Must be between 1 and 999 inclusive.
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 999 {
+			l := int32(*limit)
+			input.Top = Int32(l)
+		}
+	}
+
+	options := &users.ItemRegisteredDevicesRequestBuilderGetRequestConfiguration{
+		QueryParameters: input,
+	}
+
+	result, err := client.Users().ByUserId(userId).RegisteredDevices().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdUserRegisteredDevices", "list_user_registered_device_error", errObj)
+		return nil, errObj
+	}
+
+	err = iteratePages(ctx, d, adapter, result, models.CreateDirectoryObjectCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.DirectoryObjectable) bool {
+		// Registered objects are returned as directory objects, only devices are of interest here
+		if device, ok := pageItem.(models.Deviceable); ok {
+			d.StreamListItem(ctx, &ADUserDeviceInfo{device, &userId})
+		}
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdUserRegisteredDevices", "paging_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
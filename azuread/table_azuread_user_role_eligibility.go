@@ -0,0 +1,70 @@
+package azuread
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdUserRoleEligibility(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_user_role_eligibility",
+		Description: "Represents a directory role a user is eligible to activate through Privileged Identity Management (PIM).",
+		List: &plugin.ListConfig{
+			Hydrate: listAdUserRoleEligibilities,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "user_id", Require: plugin.Required},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "user_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the user.", Transform: transform.FromField("UserId")},
+			{Name: "role_definition_id", Type: proto.ColumnType_STRING, Description: "The identifier of the role definition the user is eligible to.", Transform: transform.FromMethod("GetRoleDefinitionId")},
+			{Name: "role_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the role definition.", Transform: transform.FromMethod("UserRoleEligibilityRoleDisplayName")},
+			{Name: "directory_scope_id", Type: proto.ColumnType_STRING, Description: "The identifier of the directory object representing the scope of the eligibility, / for the whole tenant.", Transform: transform.FromMethod("GetDirectoryScopeId")},
+			{Name: "start_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the eligibility starts.", Transform: transform.FromMethod("GetStartDateTime")},
+			{Name: "end_date_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time when the eligibility expires. Null for a permanent eligibility.", Transform: transform.FromMethod("GetEndDateTime")},
+			{Name: "member_type", Type: proto.ColumnType_STRING, Description: "How the user is eligible to the role. Possible values are: Direct, Group.", Transform: transform.FromMethod("GetMemberType")},
+
+			// Other fields
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the eligibility schedule instance.", Transform: transform.FromMethod("GetId")},
+			{Name: "role_eligibility_schedule_id", Type: proto.ColumnType_STRING, Description: "The identifier of the eligibility schedule the instance was created from.", Transform: transform.FromMethod("GetRoleEligibilityScheduleId")},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromMethod("UserRoleEligibilityRoleDisplayName")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdUserRoleEligibilities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	userId := d.EqualsQuals["user_id"].GetStringValue()
+	if userId == "" {
+		return nil, nil
+	}
+
+	// The role definitions are expanded on the same request, so their display names require no further lookup
+	eligibilities, err := listAdRoleEligibilitiesByPrincipal(ctx, d, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, eligibility := range eligibilities {
+		d.StreamListItem(ctx, &ADUserRoleEligibilityInfo{eligibility, userId})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,294 @@
+package azuread
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/rolemanagement"
+	"github.com/microsoftgraph/msgraph-sdk-go/users"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// unifiedRoleAssignmentBase is implemented by both the role assignments and the PIM eligibility schedule instances
+type unifiedRoleAssignmentBase interface {
+	GetId() *string
+	GetPrincipalId() *string
+	GetRoleDefinitionId() *string
+	GetDirectoryScopeId() *string
+	GetRoleDefinition() models.UnifiedRoleDefinitionable
+}
+
+//// TABLE DEFINITION
+
+func tableAzureAdUserTransitiveRoleAssignment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuread_user_transitive_role_assignment",
+		Description: "Represents a directory role a user holds, either directly, through the membership of a role-assignable group, or as a PIM eligible assignment.",
+		List: &plugin.ListConfig{
+			Hydrate: listAdUserTransitiveRoleAssignments,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isIgnorableErrorPredicate([]string{"Request_ResourceNotFound", "Invalid object identifier"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "user_id", Require: plugin.Required},
+			},
+		},
+
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "user_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the user.", Transform: transform.FromField("UserId")},
+			{Name: "role_definition_id", Type: proto.ColumnType_STRING, Description: "The identifier of the role definition.", Transform: transform.FromField("RoleDefinitionId")},
+			{Name: "role_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the role definition.", Transform: transform.FromField("RoleDisplayName")},
+			{Name: "assignment_path", Type: proto.ColumnType_STRING, Description: "How the user holds the role. Possible values are: direct (assigned to the user), group (assigned to a role-assignable group the user is a member of), pim (the user, or a role-assignable group the user is a member of, is eligible to activate the role).", Transform: transform.FromField("AssignmentPath")},
+			{Name: "scope_id", Type: proto.ColumnType_STRING, Description: "The identifier of the directory object representing the scope of the assignment, / for the whole tenant.", Transform: transform.FromField("ScopeId")},
+
+			// Other fields
+			{Name: "principal_id", Type: proto.ColumnType_STRING, Description: "The identifier of the principal the role is assigned to. The group ID when the role or the PIM eligibility is held through a group.", Transform: transform.FromField("PrincipalId")},
+			{Name: "role_assignment_id", Type: proto.ColumnType_STRING, Description: "The identifier of the role assignment, or of the eligibility schedule instance for PIM eligible assignments.", Transform: transform.FromField("RoleAssignmentId")},
+
+			// JSON fields
+			{Name: "scope_resolved", Type: proto.ColumnType_JSON, Description: "The scope of the assignment, with its scopeType (tenant, administrativeUnit, application or servicePrincipal) and its displayName.", Hydrate: getAdUserTransitiveRoleAssignmentScope, Transform: transform.FromValue()},
+
+			// Standard columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: ColumnDescriptionTitle, Transform: transform.FromField("RoleDisplayName")},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAdUserTransitiveRoleAssignments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	userId := d.EqualsQuals["user_id"].GetStringValue()
+	if userId == "" {
+		return nil, nil
+	}
+
+	// Roles assigned to the user
+	assignments, err := listAdRoleAssignmentsByPrincipal(ctx, d, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []*ADUserTransitiveRoleAssignmentInfo{}
+	for _, assignment := range assignments {
+		rows = append(rows, newADUserTransitiveRoleAssignmentInfo(userId, "direct", assignment))
+	}
+
+	// Roles assigned to the role-assignable groups the user is a member of
+	groupIds, err := listAdUserRoleAssignableGroupIds(ctx, d, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, groupId := range groupIds {
+		assignments, err := listAdRoleAssignmentsByPrincipal(ctx, d, groupId)
+		if err != nil {
+			return nil, err
+		}
+		for _, assignment := range assignments {
+			rows = append(rows, newADUserTransitiveRoleAssignmentInfo(userId, "group", assignment))
+		}
+	}
+
+	// Roles the user is eligible to activate through PIM, either directly or through the role-assignable groups
+	for _, principalId := range append([]string{userId}, groupIds...) {
+		eligibilities, err := listAdRoleEligibilitiesByPrincipal(ctx, d, principalId)
+		if err != nil {
+			return nil, err
+		}
+		for _, eligibility := range eligibilities {
+			rows = append(rows, newADUserTransitiveRoleAssignmentInfo(userId, "pim", eligibility))
+		}
+	}
+
+	for _, row := range rows {
+		d.StreamListItem(ctx, row)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func listAdRoleAssignmentsByPrincipal(ctx context.Context, d *plugin.QueryData, principalId string) ([]models.UnifiedRoleAssignmentable, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_user_transitive_role_assignment.listAdRoleAssignmentsByPrincipal", "connection_error", err)
+		return nil, err
+	}
+
+	filter := fmt.Sprintf("principalId eq '%s'", escapeODataString(principalId))
+	options := &rolemanagement.DirectoryRoleAssignmentsRequestBuilderGetRequestConfiguration{
+		QueryParameters: &rolemanagement.DirectoryRoleAssignmentsRequestBuilderGetQueryParameters{
+			Filter: &filter,
+			Expand: []string{"roleDefinition"},
+		},
+	}
+
+	result, err := client.RoleManagement().Directory().RoleAssignments().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdRoleAssignmentsByPrincipal", "list_role_assignment_error", errObj)
+		return nil, errObj
+	}
+
+	assignments := []models.UnifiedRoleAssignmentable{}
+	err = iteratePages(ctx, d, adapter, result, models.CreateUnifiedRoleAssignmentCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.UnifiedRoleAssignmentable) bool {
+		assignments = append(assignments, pageItem)
+		return true
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdRoleAssignmentsByPrincipal", "paging_error", err)
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+func listAdRoleEligibilitiesByPrincipal(ctx context.Context, d *plugin.QueryData, principalId string) ([]models.UnifiedRoleEligibilityScheduleInstanceable, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_user_transitive_role_assignment.listAdRoleEligibilitiesByPrincipal", "connection_error", err)
+		return nil, err
+	}
+
+	filter := fmt.Sprintf("principalId eq '%s'", escapeODataString(principalId))
+	options := &rolemanagement.DirectoryRoleEligibilityScheduleInstancesRequestBuilderGetRequestConfiguration{
+		QueryParameters: &rolemanagement.DirectoryRoleEligibilityScheduleInstancesRequestBuilderGetQueryParameters{
+			Filter: &filter,
+			Expand: []string{"roleDefinition"},
+		},
+	}
+
+	result, err := client.RoleManagement().Directory().RoleEligibilityScheduleInstances().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+
+		// PIM requires an Azure AD Premium P2 license, the user has no eligible role in tenants without it
+		if isIgnorableErrorPredicate(pimIgnorableErrors)(ctx, d, nil, errObj) {
+			return nil, nil
+		}
+
+		plugin.Logger(ctx).Error("listAdRoleEligibilitiesByPrincipal", "list_role_eligibility_schedule_instance_error", errObj)
+		return nil, errObj
+	}
+
+	eligibilities := []models.UnifiedRoleEligibilityScheduleInstanceable{}
+	err = iteratePages(ctx, d, adapter, result, models.CreateUnifiedRoleEligibilityScheduleInstanceCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.UnifiedRoleEligibilityScheduleInstanceable) bool {
+		eligibilities = append(eligibilities, pageItem)
+		return true
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdRoleEligibilitiesByPrincipal", "paging_error", err)
+		return nil, err
+	}
+
+	return eligibilities, nil
+}
+
+func listAdUserRoleAssignableGroupIds(ctx context.Context, d *plugin.QueryData, userId string) ([]string, error) {
+	// Create client
+	client, adapter, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuread_user_transitive_role_assignment.listAdUserRoleAssignableGroupIds", "connection_error", err)
+		return nil, err
+	}
+
+	options := &users.ItemTransitiveMemberOfGraphGroupRequestBuilderGetRequestConfiguration{
+		QueryParameters: &users.ItemTransitiveMemberOfGraphGroupRequestBuilderGetQueryParameters{
+			Select: []string{"id", "isAssignableToRole"},
+		},
+	}
+
+	result, err := client.Users().ByUserId(userId).TransitiveMemberOf().GraphGroup().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("listAdUserRoleAssignableGroupIds", "list_transitive_member_of_error", errObj)
+		return nil, errObj
+	}
+
+	// Only the role-assignable groups can be assigned a directory role
+	groupIds := []string{}
+	err = iteratePages(ctx, d, adapter, result, models.CreateGroupCollectionResponseFromDiscriminatorValue, nil, func(pageItem models.Groupable) bool {
+		if pageItem.GetId() != nil && pageItem.GetIsAssignableToRole() != nil && *pageItem.GetIsAssignableToRole() {
+			groupIds = append(groupIds, *pageItem.GetId())
+		}
+		return true
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAdUserRoleAssignableGroupIds", "paging_error", err)
+		return nil, err
+	}
+
+	return groupIds, nil
+}
+
+func getAdUserTransitiveRoleAssignmentScope(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	assignment := h.Item.(*ADUserTransitiveRoleAssignmentInfo)
+	if assignment.ScopeId == nil {
+		return nil, nil
+	}
+
+	return resolveDirectoryScope(ctx, d, *assignment.ScopeId)
+}
+
+// resolveDirectoryScope describes the directoryScopeId of a role assignment, which is / for the whole tenant,
+// /administrativeUnits/{id} for an administrative unit, or /{id} for an application or a service principal
+func resolveDirectoryScope(ctx context.Context, d *plugin.QueryData, scopeId string) (map[string]interface{}, error) {
+	if scopeId == "/" {
+		return map[string]interface{}{"scopeType": "tenant"}, nil
+	}
+
+	scopeType := ""
+	objectId := strings.TrimPrefix(scopeId, "/")
+	if strings.HasPrefix(objectId, "administrativeUnits/") {
+		scopeType = "administrativeUnit"
+		objectId = strings.TrimPrefix(objectId, "administrativeUnits/")
+	}
+
+	scope := map[string]interface{}{"scopeType": scopeType}
+
+	directoryObject, err := resolveDirectoryObjectName(ctx, d, objectId)
+	if err != nil {
+		// The scope object may have been deleted since the assignment was made
+		if isIgnorableErrorPredicate(directoryObjectNotFoundErrors)(ctx, d, nil, err) {
+			return scope, nil
+		}
+		return nil, err
+	}
+
+	if scopeType == "" {
+		scope["scopeType"] = directoryObject.ObjectType
+	}
+	if directoryObject.DisplayName != nil {
+		scope["displayName"] = *directoryObject.DisplayName
+	}
+
+	return scope, nil
+}
+
+func newADUserTransitiveRoleAssignmentInfo(userId string, assignmentPath string, assignment unifiedRoleAssignmentBase) *ADUserTransitiveRoleAssignmentInfo {
+	row := &ADUserTransitiveRoleAssignmentInfo{
+		UserId:           &userId,
+		RoleAssignmentId: assignment.GetId(),
+		RoleDefinitionId: assignment.GetRoleDefinitionId(),
+		AssignmentPath:   assignmentPath,
+		PrincipalId:      assignment.GetPrincipalId(),
+		ScopeId:          assignment.GetDirectoryScopeId(),
+	}
+	if assignment.GetRoleDefinition() != nil {
+		row.RoleDisplayName = assignment.GetRoleDefinition().GetDisplayName()
+	}
+	return row
+}
@@ -1,13 +1,31 @@
 package azuread
 
 import (
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/microsoft/kiota-abstractions-go/serialization"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/models/identitygovernance"
+	"github.com/turbot/go-kit/helpers"
 )
 
+type ADAccessPackageInfo struct {
+	models.AccessPackageable
+}
+
 type ADAdminConsentRequestPolicyInfo struct {
 	models.AdminConsentRequestPolicyable
 }
 
+type ADAdministrativeUnitInfo struct {
+	models.AdministrativeUnitable
+}
+
 type ADApplicationInfo struct {
 	models.Applicationable
 	IsAuthorizationServiceEnabled interface{}
@@ -18,18 +36,110 @@ type ADApplicationAppRoleAssignmentInfo struct {
 	ApplicationId *string
 }
 
+type ADApplicationExposedScopeInfo struct {
+	models.PermissionScopeable
+	AppObjectId *string
+	AppId       *string
+}
+
+type ADApplicationPermissionInfo struct {
+	ApplicationId       *string
+	AppId               *string
+	ResourceAppId       *string
+	ResourceId          *string
+	ResourceDisplayName *string
+	PermissionId        string
+	PermissionType      *string
+	PermissionValue     *string
+	ConsentStatus       string
+}
+
+type ADApplicationRedirectUriInfo struct {
+	AppObjectId *string
+	AppId       *string
+	Platform    string
+	Uri         string
+}
+
+type ADAppManagementPolicyInfo struct {
+	Id                  *string
+	DisplayName         *string
+	Description         *string
+	PolicyType          string
+	IsEnabled           *bool
+	PasswordCredentials []models.PasswordCredentialConfigurationable
+	KeyCredentials      []models.KeyCredentialConfigurationable
+}
+
+type ADAppRoleInfo struct {
+	models.AppRoleable
+	ResourceId *string
+}
+
 type ADAppRoleAssignmentInfo struct {
 	models.AppRoleAssignmentable
 }
 
+type ADAuthenticationContextClassReferenceInfo struct {
+	models.AuthenticationContextClassReferenceable
+}
+
+type ADAuthenticationStrengthPolicyInfo struct {
+	models.AuthenticationStrengthPolicyable
+}
+
 type ADAuthorizationPolicyInfo struct {
 	models.AuthorizationPolicyable
 }
 
+type ADB2CIdentityProviderInfo struct {
+	models.SocialIdentityProviderable
+}
+
+type ADB2CUserAttributeInfo struct {
+	models.IdentityUserFlowAttributeable
+}
+
+type ADCertificateAuthorityInfo struct {
+	models.CertificateAuthorityable
+	CertificateBasedAuthConfigurationId *string
+}
+
+type ADConditionalAccessCoverageInfo struct {
+	Scenario             string
+	Description          string
+	Covered              bool
+	EnforcingPolicyIds   []string
+	ReportOnlyPolicyIds  []string
+	ExcludedPrincipalIds []string
+}
+
+type ADConditionalAccessExcludedPrincipalInfo struct {
+	PolicyId          *string
+	PolicyDisplayName *string
+	ExclusionType     string
+	PrincipalId       string
+	PolicyExclusions  []string
+}
+
 type ADConditionalAccessPolicyInfo struct {
 	models.ConditionalAccessPolicyable
 }
 
+type ADCrossTenantPartnerInfo struct {
+	models.CrossTenantAccessPolicyConfigurationPartnerable
+}
+
+type ADCustomSecurityAttributeDefinitionInfo struct {
+	models.CustomSecurityAttributeDefinitionable
+	AttributeSet models.AttributeSetable
+}
+
+type ADDelegatedPermissionClassificationInfo struct {
+	models.DelegatedPermissionClassificationable
+	ServicePrincipalId *string
+}
+
 type ADDeviceInfo struct {
 	models.Deviceable
 }
@@ -38,6 +148,32 @@ type ADDirectoryAuditReportInfo struct {
 	models.DirectoryAuditable
 }
 
+type ADDirectoryObjectInfo struct {
+	models.DirectoryObjectable
+}
+
+type ADDirectoryObjectMemberOfInfo struct {
+	ADDirectoryObjectInfo
+	ObjectId   *string
+	ObjectType string
+	Transitive bool
+}
+
+type ADDirectoryRoleAssignmentInfo struct {
+	models.UnifiedRoleAssignmentable
+}
+
+type ADDirectoryRoleMemberInfo struct {
+	ADDirectoryObjectInfo
+	RoleId          *string
+	RoleDisplayName *string
+}
+
+type ADDirectoryRoleTemplateInfo struct {
+	models.DirectoryRoleTemplateable
+	RoleId *string
+}
+
 type ADDirectorySettingInfo struct {
 	// models.GroupSettingable
 	DisplayName *string
@@ -47,16 +183,58 @@ type ADDirectorySettingInfo struct {
 	Value       *string
 }
 
+type ADDomainDnsRecordInfo struct {
+	models.DomainDnsRecordable
+	DomainId *string
+}
+
+type ADExternalIdentitiesPolicyInfo struct {
+	models.AuthenticationFlowsPolicyable
+}
+
+type ADGroupAssignedLicenseInfo struct {
+	models.AssignedLicenseable
+	GroupId         *string
+	ProcessingState *string
+}
+
 type ADGroupInfo struct {
 	models.Groupable
 	ResourceBehaviorOptions     []string
 	ResourceProvisioningOptions []string
 }
 
+type ADGroupMembershipChangeInfo struct {
+	ADDirectoryAuditReportInfo
+	Action            string
+	GroupId           *string
+	GroupDisplayName  *string
+	MemberId          *string
+	MemberDisplayName *string
+	MemberType        *string
+}
+
+type ADGroupPimAssignmentInfo struct {
+	models.PrivilegedAccessGroupAssignmentScheduleInstanceable
+}
+
+type ADGuestInvitationInfo struct {
+	models.Userable
+}
+
+type ADHomeRealmDiscoveryPolicyInfo struct {
+	models.HomeRealmDiscoveryPolicyable
+}
+
 type ADIdentityProviderInfo struct {
-	models.BuiltInIdentityProvider
-	ClientId     interface{}
-	ClientSecret interface{}
+	models.IdentityProviderBaseable
+	IdentityProviderType *string
+	ClientId             interface{}
+	ClientSecret         interface{}
+}
+
+type ADLifecycleWorkflowInfo struct {
+	identitygovernance.Workflowable
 }
 
 type ADNamedLocationInfo struct {
@@ -72,18 +250,132 @@ type ADCountryNamedLocationInfo struct {
 	models.CountryNamedLocationable
 }
 
+type ADOrganizationBrandingInfo struct {
+	models.OrganizationalBrandingLocalizationable
+	OrganizationId *string
+}
+
+type ADPimAssignmentScheduleInfo struct {
+	models.UnifiedRoleAssignmentScheduleable
+}
+
+type ADPimAssignmentScheduleInstanceInfo struct {
+	models.UnifiedRoleAssignmentScheduleInstanceable
+}
+
+type ADPimEligibilityScheduleInfo struct {
+	models.UnifiedRoleEligibilityScheduleable
+}
+
+type ADPolicyInfo struct {
+	PolicyType            string
+	Id                    *string
+	DisplayName           *string
+	Description           *string
+	IsOrganizationDefault *bool
+	Definition            []string
+}
+
+type ADProvisioningObjectSummaryInfo struct {
+	models.ProvisioningObjectSummaryable
+}
+
+type ADRiskDetectionInfo struct {
+	models.RiskDetectionable
+}
+
+type ADRoleDefinitionResourceActionInfo struct {
+	models.UnifiedRbacResourceActionable
+	Namespace *string
+}
+
+type ADRoleManagementPolicyInfo struct {
+	models.UnifiedRoleManagementPolicyAssignmentable
+}
+
+type ADRoleScheduleRequestInfo struct {
+	models.Requestable
+	RequestType      string
+	Action           *string
+	PrincipalId      *string
+	RoleDefinitionId *string
+	DirectoryScopeId *string
+	Justification    *string
+	TargetScheduleId *string
+	ScheduleInfo     models.RequestScheduleable
+	TicketInfo       models.TicketInfoable
+}
+
+type ADSecureScoreInfo struct {
+	models.SecureScoreable
+}
+
+type ADSecureScoreControlProfileInfo struct {
+	models.SecureScoreControlProfileable
+}
+
 type ADSecurityDefaultsPolicyInfo struct {
 	models.IdentitySecurityDefaultsEnforcementPolicyable
 }
 
+type ADServiceHealthInfo struct {
+	models.ServiceHealthable
+}
+
+type ADServiceMessageInfo struct {
+	models.ServiceUpdateMessageable
+}
+
+type ADServicePrincipalCredentialInfo struct {
+	ServicePrincipalId          *string
+	ServicePrincipalDisplayName *string
+	AppId                       *string
+	CredentialType              string
+	KeyId                       *string
+	DisplayName                 *string
+	StartDateTime               *time.Time
+	EndDateTime                 *time.Time
+	CustomKeyIdentifier         []byte
+	Key                         []byte
+	Usage                       *string
+	Type                        *string
+	Hint                        *string
+	ExpiryWarningPeriod         time.Duration
+}
+
 type ADServicePrincipalInfo struct {
 	models.ServicePrincipalable
+	ExpiryWarningPeriod time.Duration
+}
+
+type ADServicePrincipalSignInInfo struct {
+	ADSignInReportInfo
+	ServicePrincipalId   interface{}
+	ServicePrincipalName interface{}
+}
+
+type ADServicePrincipalTokenPolicyInfo struct {
+	models.StsPolicyable
+	ServicePrincipalId *string
+	PolicyType         string
 }
 
 type ADSignInReportInfo struct {
 	models.SignInable
 }
 
+type ADTenantInfo struct {
+	models.Organizationable
+}
+
+type ADTenantRelationshipInfo struct {
+	models.DelegatedAdminRelationshipable
+}
+
+type ADTermsOfUseAgreementInfo struct {
+	models.Agreementable
+}
+
 type ADUserInfo struct {
 	models.Userable
 	RefreshTokensValidFromDateTime interface{}
@@ -94,6 +386,39 @@ type ADUserAppRoleAssignmentInfo struct {
 	UserId *string
 }
 
+type ADUserDeviceInfo struct {
+	models.Deviceable
+	UserId *string
+}
+
+type ADUserOwnedObjectInfo struct {
+	ADDirectoryObjectInfo
+	UserId       *string
+	Relationship string
+}
+
+type ADUserRoleEligibilityInfo struct {
+	models.UnifiedRoleEligibilityScheduleInstanceable
+	UserId string
+}
+
+type ADUserTransitiveRoleAssignmentInfo struct {
+	UserId           *string
+	RoleAssignmentId *string
+	RoleDefinitionId *string
+	RoleDisplayName  *string
+	AssignmentPath   string
+	PrincipalId      *string
+	ScopeId          *string
+}
+
+func (accessPackage *ADAccessPackageInfo) AccessPackageCatalogId() *string {
+	if accessPackage.GetCatalog() == nil {
+		return nil
+	}
+	return accessPackage.GetCatalog().GetId()
+}
+
 func (adminConsentRequestPolicy *ADAdminConsentRequestPolicyInfo) AdminConsentRequestPolicyReviewers() []map[string]interface{} {
 	if adminConsentRequestPolicy.GetReviewers() == nil {
 		return nil
@@ -120,6 +445,108 @@ func (adminConsentRequestPolicy *ADAdminConsentRequestPolicyInfo) AdminConsentRe
 	return reviewers
 }
 
+func (administrativeUnit *ADAdministrativeUnitInfo) AdministrativeUnitIsMemberManagementRestricted() *bool {
+	if isMemberManagementRestricted, ok := administrativeUnit.GetAdditionalData()["isMemberManagementRestricted"].(*bool); ok {
+		return isMemberManagementRestricted
+	}
+	return nil
+}
+
+func (administrativeUnit *ADAdministrativeUnitInfo) AdministrativeUnitRestrictionType() string {
+	// The property is null on the units created before the restricted management was introduced, which are not restricted
+	isMemberManagementRestricted := administrativeUnit.AdministrativeUnitIsMemberManagementRestricted()
+	if isMemberManagementRestricted != nil && *isMemberManagementRestricted {
+		return "restrictedManagement"
+	}
+	return "none"
+}
+
+func (policy *ADAppManagementPolicyInfo) AppManagementPolicyKeyCredentials() []map[string]interface{} {
+	keyCredentials := []map[string]interface{}{}
+	for _, c := range policy.KeyCredentials {
+		keyCredentialData := map[string]interface{}{}
+		if c.GetRestrictionType() != nil {
+			keyCredentialData["restrictionType"] = c.GetRestrictionType().String()
+		}
+		if c.GetMaxLifetime() != nil {
+			keyCredentialData["maxLifetime"] = c.GetMaxLifetime().String()
+		}
+		if c.GetRestrictForAppsCreatedAfterDateTime() != nil {
+			keyCredentialData["restrictForAppsCreatedAfterDateTime"] = *c.GetRestrictForAppsCreatedAfterDateTime()
+		}
+		keyCredentials = append(keyCredentials, keyCredentialData)
+	}
+	return keyCredentials
+}
+
+func (policy *ADAppManagementPolicyInfo) AppManagementPolicyMaxCertificateLifetimeDays() *int64 {
+	var maxLifetimeDays *int64
+	for _, c := range policy.KeyCredentials {
+		if c.GetRestrictionType() == nil || c.GetRestrictionType().String() != "asymmetricKeyLifetime" {
+			continue
+		}
+		if days := isoDurationDays(c.GetMaxLifetime()); days != nil && (maxLifetimeDays == nil || *days < *maxLifetimeDays) {
+			maxLifetimeDays = days
+		}
+	}
+	return maxLifetimeDays
+}
+
+func (policy *ADAppManagementPolicyInfo) AppManagementPolicyMaxPasswordCredentialLifetimeDays() *int64 {
+	var maxLifetimeDays *int64
+	for _, c := range policy.PasswordCredentials {
+		if c.GetRestrictionType() == nil || c.GetRestrictionType().String() != "passwordLifetime" {
+			continue
+		}
+		if days := isoDurationDays(c.GetMaxLifetime()); days != nil && (maxLifetimeDays == nil || *days < *maxLifetimeDays) {
+			maxLifetimeDays = days
+		}
+	}
+	return maxLifetimeDays
+}
+
+func (policy *ADAppManagementPolicyInfo) AppManagementPolicyPasswordCredentials() []map[string]interface{} {
+	passwordCredentials := []map[string]interface{}{}
+	for _, c := range policy.PasswordCredentials {
+		passwordCredentialData := map[string]interface{}{}
+		if c.GetRestrictionType() != nil {
+			passwordCredentialData["restrictionType"] = c.GetRestrictionType().String()
+		}
+		if c.GetMaxLifetime() != nil {
+			passwordCredentialData["maxLifetime"] = c.GetMaxLifetime().String()
+		}
+		if c.GetRestrictForAppsCreatedAfterDateTime() != nil {
+			passwordCredentialData["restrictForAppsCreatedAfterDateTime"] = *c.GetRestrictForAppsCreatedAfterDateTime()
+		}
+		passwordCredentials = append(passwordCredentials, passwordCredentialData)
+	}
+	return passwordCredentials
+}
+
+func (policy *ADAppManagementPolicyInfo) AppManagementPolicyPasswordCredentialsBlocked() bool {
+	for _, c := range policy.PasswordCredentials {
+		if c.GetRestrictionType() != nil && c.GetRestrictionType().String() == "passwordAddition" {
+			return true
+		}
+	}
+	return false
+}
+
+func (scope *ADApplicationExposedScopeInfo) ApplicationExposedScopeId() *string {
+	if scope.GetId() == nil {
+		return nil
+	}
+	scopeId := scope.GetId().String()
+	return &scopeId
+}
+
+func (application *ADApplicationInfo) ApplicationAllowPublicClientFlows() bool {
+	if application.GetIsFallbackPublicClient() != nil && *application.GetIsFallbackPublicClient() {
+		return true
+	}
+	return len(application.ApplicationPublicClientRedirectUris()) > 0
+}
+
 func (application *ADApplicationInfo) ApplicationAPI() map[string]interface{} {
 	if application.GetApi() == nil {
 		return nil
@@ -237,6 +664,18 @@ func (application *ADApplicationInfo) ApplicationKeyCredentials() []map[string]i
 	return keyCredentials
 }
 
+func (application *ADApplicationInfo) ApplicationOptionalClaims() map[string]interface{} {
+	if application.GetOptionalClaims() == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"accessToken": optionalClaimsToMap(application.GetOptionalClaims().GetAccessToken()),
+		"idToken":     optionalClaimsToMap(application.GetOptionalClaims().GetIdToken()),
+		"saml2Token":  optionalClaimsToMap(application.GetOptionalClaims().GetSaml2Token()),
+	}
+}
+
 func (application *ADApplicationInfo) ApplicationParentalControlSettings() map[string]interface{} {
 	if application.GetParentalControlSettings() == nil {
 		return nil
@@ -287,6 +726,13 @@ func (application *ADApplicationInfo) ApplicationPasswordCredentials() []map[str
 	return passwordCredentials
 }
 
+func (application *ADApplicationInfo) ApplicationPublicClientRedirectUris() []string {
+	if application.GetPublicClient() == nil {
+		return nil
+	}
+	return application.GetPublicClient().GetRedirectUris()
+}
+
 func (application *ADApplicationInfo) ApplicationSpa() map[string]interface{} {
 	if application.GetSpa() == nil {
 		return nil
@@ -297,6 +743,14 @@ func (application *ADApplicationInfo) ApplicationSpa() map[string]interface{} {
 	}
 }
 
+func (application *ADApplicationInfo) ApplicationTokenEncryptionKeyId() *string {
+	if application.GetTokenEncryptionKeyId() == nil {
+		return nil
+	}
+	keyId := application.GetTokenEncryptionKeyId().String()
+	return &keyId
+}
+
 func (application *ADApplicationInfo) ApplicationWeb() map[string]interface{} {
 	if application.GetWeb() == nil {
 		return nil
@@ -327,6 +781,32 @@ func (application *ADApplicationInfo) ApplicationWeb() map[string]interface{} {
 	return webData
 }
 
+func (authenticationStrengthPolicy *ADAuthenticationStrengthPolicyInfo) AuthenticationStrengthPolicyAllowedCombinations() []string {
+	if authenticationStrengthPolicy.GetAllowedCombinations() == nil {
+		return nil
+	}
+
+	allowedCombinations := []string{}
+	for _, c := range authenticationStrengthPolicy.GetAllowedCombinations() {
+		allowedCombinations = append(allowedCombinations, c.String())
+	}
+	return allowedCombinations
+}
+
+func (authenticationStrengthPolicy *ADAuthenticationStrengthPolicyInfo) AuthenticationStrengthPolicyPolicyType() string {
+	if authenticationStrengthPolicy.GetPolicyType() == nil {
+		return ""
+	}
+	return authenticationStrengthPolicy.GetPolicyType().String()
+}
+
+func (authenticationStrengthPolicy *ADAuthenticationStrengthPolicyInfo) AuthenticationStrengthPolicyRequirementsSatisfied() string {
+	if authenticationStrengthPolicy.GetRequirementsSatisfied() == nil {
+		return ""
+	}
+	return authenticationStrengthPolicy.GetRequirementsSatisfied().String()
+}
+
 func (authorizationPolicy *ADAuthorizationPolicyInfo) AuthorizationPolicyDefaultUserRolePermissions() map[string]interface{} {
 	if authorizationPolicy.GetDefaultUserRolePermissions() == nil {
 		return nil
@@ -356,6 +836,27 @@ func (authorizationPolicy *ADAuthorizationPolicyInfo) AuthorizationPolicyAllowIn
 	return authorizationPolicy.GetAllowInvitesFrom().String()
 }
 
+func (userAttribute *ADB2CUserAttributeInfo) B2CUserAttributeDataType() string {
+	if userAttribute.GetDataType() == nil {
+		return ""
+	}
+	return userAttribute.GetDataType().String()
+}
+
+func (userAttribute *ADB2CUserAttributeInfo) B2CUserAttributeUserFlowAttributeType() string {
+	if userAttribute.GetUserFlowAttributeType() == nil {
+		return ""
+	}
+	return userAttribute.GetUserFlowAttributeType().String()
+}
+
+func (conditionalAccessPolicy *ADConditionalAccessPolicyInfo) ConditionalAccessPolicyAppliesToAllUsers() bool {
+	if conditionalAccessPolicy.GetConditions() == nil || conditionalAccessPolicy.GetConditions().GetUsers() == nil {
+		return false
+	}
+	return helpers.StringSliceContains(conditionalAccessPolicy.GetConditions().GetUsers().GetIncludeUsers(), "All")
+}
+
 func (conditionalAccessPolicy *ADConditionalAccessPolicyInfo) ConditionalAccessPolicyConditionsApplications() map[string]interface{} {
 	if conditionalAccessPolicy.GetConditions() == nil {
 		return nil
@@ -443,6 +944,18 @@ func (conditionalAccessPolicy *ADConditionalAccessPolicyInfo) ConditionalAccessP
 	return conditionalAccessPolicy.GetConditions().GetUserRiskLevels()
 }
 
+func (conditionalAccessPolicy *ADConditionalAccessPolicyInfo) ConditionalAccessPolicyExcludesPrivilegedRoles() bool {
+	if conditionalAccessPolicy.GetConditions() == nil || conditionalAccessPolicy.GetConditions().GetUsers() == nil {
+		return false
+	}
+	for _, roleId := range conditionalAccessPolicy.GetConditions().GetUsers().GetExcludeRoles() {
+		if _, ok := privilegedRoleTemplateIds[roleId]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 func (conditionalAccessPolicy *ADConditionalAccessPolicyInfo) ConditionalAccessPolicyGrantControlsBuiltInControls() []models.ConditionalAccessGrantControl {
 	if conditionalAccessPolicy.GetGrantControls() == nil {
 		return nil
@@ -481,6 +994,30 @@ func (conditionalAccessPolicy *ADConditionalAccessPolicyInfo) ConditionalAccessP
 	return conditionalAccessPolicy.GetGrantControls().GetTermsOfUse()
 }
 
+func (conditionalAccessPolicy *ADConditionalAccessPolicyInfo) ConditionalAccessPolicyRequiresMfa() bool {
+	return conditionalAccessPolicy.requiresGrantControl("mfa", "authenticationStrength")
+}
+
+func (conditionalAccessPolicy *ADConditionalAccessPolicyInfo) ConditionalAccessPolicyRequiresApprovedApplication() bool {
+	return conditionalAccessPolicy.requiresGrantControl("approvedApplication")
+}
+
+func (conditionalAccessPolicy *ADConditionalAccessPolicyInfo) ConditionalAccessPolicyRequiresCompliantDevice() bool {
+	return conditionalAccessPolicy.requiresGrantControl("compliantDevice")
+}
+
+func (conditionalAccessPolicy *ADConditionalAccessPolicyInfo) ConditionalAccessPolicyRequiresHybridAzureAdJoinedDevice() bool {
+	return conditionalAccessPolicy.requiresGrantControl("domainJoinedDevice")
+}
+
+func (conditionalAccessPolicy *ADConditionalAccessPolicyInfo) ConditionalAccessPolicySessionControlsApplicationEnforcedRestrictionsEnabled() bool {
+	if conditionalAccessPolicy.GetSessionControls() == nil || conditionalAccessPolicy.GetSessionControls().GetApplicationEnforcedRestrictions() == nil {
+		return false
+	}
+	isEnabled := conditionalAccessPolicy.GetSessionControls().GetApplicationEnforcedRestrictions().GetIsEnabled()
+	return isEnabled != nil && *isEnabled
+}
+
 func (conditionalAccessPolicy *ADConditionalAccessPolicyInfo) ConditionalAccessPolicySessionControlsApplicationEnforcedRestrictions() map[string]interface{} {
 	if conditionalAccessPolicy.GetSessionControls() == nil {
 		return nil
@@ -512,11 +1049,24 @@ func (conditionalAccessPolicy *ADConditionalAccessPolicyInfo) ConditionalAccessP
 		data["isEnabled"] = conditionalAccessPolicy.GetSessionControls().GetCloudAppSecurity().GetIsEnabled()
 	}
 	if conditionalAccessPolicy.GetSessionControls().GetCloudAppSecurity().GetCloudAppSecurityType() != nil {
-		data["cloudAppSecurityType"] = conditionalAccessPolicy.GetSessionControls().GetCloudAppSecurity().GetCloudAppSecurityType()
+		data["cloudAppSecurityType"] = conditionalAccessPolicy.GetSessionControls().GetCloudAppSecurity().GetCloudAppSecurityType().String()
 	}
 	return data
 }
 
+func (conditionalAccessPolicy *ADConditionalAccessPolicyInfo) ConditionalAccessPolicySessionControlsCloudAppSecurityType() *string {
+	if conditionalAccessPolicy.GetSessionControls() == nil || conditionalAccessPolicy.GetSessionControls().GetCloudAppSecurity() == nil {
+		return nil
+	}
+	cloudAppSecurity := conditionalAccessPolicy.GetSessionControls().GetCloudAppSecurity()
+	if cloudAppSecurity.GetIsEnabled() == nil || !*cloudAppSecurity.GetIsEnabled() || cloudAppSecurity.GetCloudAppSecurityType() == nil {
+		return nil
+	}
+
+	cloudAppSecurityType := cloudAppSecurity.GetCloudAppSecurityType().String()
+	return &cloudAppSecurityType
+}
+
 func (conditionalAccessPolicy *ADConditionalAccessPolicyInfo) ConditionalAccessPolicySessionControlsPersistentBrowser() map[string]interface{} {
 	if conditionalAccessPolicy.GetSessionControls() == nil {
 		return nil
@@ -530,7 +1080,7 @@ func (conditionalAccessPolicy *ADConditionalAccessPolicyInfo) ConditionalAccessP
 		data["isEnabled"] = conditionalAccessPolicy.GetSessionControls().GetPersistentBrowser().GetIsEnabled()
 	}
 	if conditionalAccessPolicy.GetSessionControls().GetPersistentBrowser().GetMode() != nil {
-		data["mode"] = conditionalAccessPolicy.GetSessionControls().GetPersistentBrowser().GetMode()
+		data["mode"] = conditionalAccessPolicy.GetSessionControls().GetPersistentBrowser().GetMode().String()
 	}
 	return data
 }
@@ -550,30 +1100,92 @@ func (conditionalAccessPolicy *ADConditionalAccessPolicyInfo) ConditionalAccessP
 	if conditionalAccessPolicy.GetSessionControls().GetSignInFrequency().GetValue() != nil {
 		data["value"] = conditionalAccessPolicy.GetSessionControls().GetSignInFrequency().GetValue()
 	}
+	if conditionalAccessPolicy.GetSessionControls().GetSignInFrequency().GetTypeEscaped() != nil {
+		data["type"] = conditionalAccessPolicy.GetSessionControls().GetSignInFrequency().GetTypeEscaped().String()
+	}
+	if conditionalAccessPolicy.GetSessionControls().GetSignInFrequency().GetFrequencyInterval() != nil {
+		data["frequencyInterval"] = conditionalAccessPolicy.GetSessionControls().GetSignInFrequency().GetFrequencyInterval().String()
+	}
 	return data
 }
 
-func (device *ADDeviceInfo) DeviceMemberOf() []map[string]interface{} {
-	if device.GetMemberOf() == nil {
-		return nil
+func (conditionalAccessPolicy *ADConditionalAccessPolicyInfo) ConditionalAccessPolicyState() string {
+	if conditionalAccessPolicy.GetState() == nil {
+		return ""
 	}
+	return conditionalAccessPolicy.GetState().String()
+}
 
-	members := []map[string]interface{}{}
-	for _, i := range device.GetMemberOf() {
-		member := map[string]interface{}{
-			"@odata.type": i.GetOdataType(),
-			"id":          i.GetId(),
-		}
-		members = append(members, member)
-	}
-	return members
+func (partner *ADCrossTenantPartnerInfo) CrossTenantPartnerAutomaticUserConsentSettings() interface{} {
+	return parsableToJson(partner.GetAutomaticUserConsentSettings())
 }
 
-func (directoryAuditReport *ADDirectoryAuditReportInfo) DirectoryAuditAdditionalDetails() []map[string]interface{} {
-	if directoryAuditReport.GetAdditionalDetails() == nil {
+func (partner *ADCrossTenantPartnerInfo) CrossTenantPartnerB2bCollaborationInbound() interface{} {
+	return parsableToJson(partner.GetB2bCollaborationInbound())
+}
+
+func (partner *ADCrossTenantPartnerInfo) CrossTenantPartnerB2bCollaborationOutbound() interface{} {
+	return parsableToJson(partner.GetB2bCollaborationOutbound())
+}
+
+func (partner *ADCrossTenantPartnerInfo) CrossTenantPartnerB2bDirectConnectInbound() interface{} {
+	return parsableToJson(partner.GetB2bDirectConnectInbound())
+}
+
+func (partner *ADCrossTenantPartnerInfo) CrossTenantPartnerB2bDirectConnectOutbound() interface{} {
+	return parsableToJson(partner.GetB2bDirectConnectOutbound())
+}
+
+func (partner *ADCrossTenantPartnerInfo) CrossTenantPartnerInboundTrust() interface{} {
+	return parsableToJson(partner.GetInboundTrust())
+}
+
+func (partner *ADCrossTenantPartnerInfo) CrossTenantPartnerTenantRestrictions() interface{} {
+	return parsableToJson(partner.GetTenantRestrictions())
+}
+
+func (definition *ADCustomSecurityAttributeDefinitionInfo) CustomSecurityAttributeDefinitionAttributeSetDescription() *string {
+	if definition.AttributeSet == nil {
 		return nil
 	}
-	additionalDetails := []map[string]interface{}{}
+	return definition.AttributeSet.GetDescription()
+}
+
+func (definition *ADCustomSecurityAttributeDefinitionInfo) CustomSecurityAttributeDefinitionAttributeSetMaxAttributes() *int32 {
+	if definition.AttributeSet == nil {
+		return nil
+	}
+	return definition.AttributeSet.GetMaxAttributesPerSet()
+}
+
+func (classification *ADDelegatedPermissionClassificationInfo) DelegatedPermissionClassificationClassification() string {
+	if classification.GetClassification() == nil {
+		return ""
+	}
+	return classification.GetClassification().String()
+}
+
+func (device *ADDeviceInfo) DeviceMemberOf() []map[string]interface{} {
+	if device.GetMemberOf() == nil {
+		return nil
+	}
+
+	members := []map[string]interface{}{}
+	for _, i := range device.GetMemberOf() {
+		member := map[string]interface{}{
+			"@odata.type": i.GetOdataType(),
+			"id":          i.GetId(),
+		}
+		members = append(members, member)
+	}
+	return members
+}
+
+func (directoryAuditReport *ADDirectoryAuditReportInfo) DirectoryAuditAdditionalDetails() []map[string]interface{} {
+	if directoryAuditReport.GetAdditionalDetails() == nil {
+		return nil
+	}
+	additionalDetails := []map[string]interface{}{}
 
 	for _, i := range directoryAuditReport.GetAdditionalDetails() {
 		data := map[string]interface{}{}
@@ -641,6 +1253,45 @@ func (directoryAuditReport *ADDirectoryAuditReportInfo) DirectoryAuditInitiatedB
 	return data
 }
 
+func (directoryAuditReport *ADDirectoryAuditReportInfo) DirectoryAuditInitiatedByAppId() *string {
+	if directoryAuditReport.GetInitiatedBy() == nil || directoryAuditReport.GetInitiatedBy().GetApp() == nil {
+		return nil
+	}
+	return directoryAuditReport.GetInitiatedBy().GetApp().GetAppId()
+}
+
+func (directoryAuditReport *ADDirectoryAuditReportInfo) DirectoryAuditInitiatedByDisplayName() *string {
+	if directoryAuditReport.GetInitiatedBy() == nil {
+		return nil
+	}
+
+	// The activity is initiated either by a user or by an app
+	if user := directoryAuditReport.GetInitiatedBy().GetUser(); user != nil && user.GetDisplayName() != nil {
+		return user.GetDisplayName()
+	}
+	if app := directoryAuditReport.GetInitiatedBy().GetApp(); app != nil {
+		if app.GetDisplayName() != nil {
+			return app.GetDisplayName()
+		}
+		return app.GetServicePrincipalName()
+	}
+	return nil
+}
+
+func (directoryAuditReport *ADDirectoryAuditReportInfo) DirectoryAuditInitiatedByUserId() *string {
+	if directoryAuditReport.GetInitiatedBy() == nil || directoryAuditReport.GetInitiatedBy().GetUser() == nil {
+		return nil
+	}
+	return directoryAuditReport.GetInitiatedBy().GetUser().GetId()
+}
+
+func (directoryAuditReport *ADDirectoryAuditReportInfo) DirectoryAuditInitiatedByUserPrincipalName() *string {
+	if directoryAuditReport.GetInitiatedBy() == nil || directoryAuditReport.GetInitiatedBy().GetUser() == nil {
+		return nil
+	}
+	return directoryAuditReport.GetInitiatedBy().GetUser().GetUserPrincipalName()
+}
+
 func (directoryAuditReport *ADDirectoryAuditReportInfo) DirectoryAuditResult() string {
 	if directoryAuditReport.GetResult() == nil {
 		return ""
@@ -700,6 +1351,27 @@ func (directoryAuditReport *ADDirectoryAuditReportInfo) DirectoryAuditTargetReso
 	return targetResources
 }
 
+func (directoryAuditReport *ADDirectoryAuditReportInfo) DirectoryAuditTargetDisplayName() *string {
+	if len(directoryAuditReport.GetTargetResources()) == 0 {
+		return nil
+	}
+	return directoryAuditReport.GetTargetResources()[0].GetDisplayName()
+}
+
+func (directoryAuditReport *ADDirectoryAuditReportInfo) DirectoryAuditTargetId() *string {
+	if len(directoryAuditReport.GetTargetResources()) == 0 {
+		return nil
+	}
+	return directoryAuditReport.GetTargetResources()[0].GetId()
+}
+
+func (directoryAuditReport *ADDirectoryAuditReportInfo) DirectoryAuditTargetType() *string {
+	if len(directoryAuditReport.GetTargetResources()) == 0 {
+		return nil
+	}
+	return directoryAuditReport.GetTargetResources()[0].GetTypeEscaped()
+}
+
 // func (directorySetting *ADDirectorySettingInfo) DirectorySettingValues() []map[string]interface{} {
 // 	if directorySetting.GetValues() == nil {
 // 		return nil
@@ -722,6 +1394,79 @@ func (directoryAuditReport *ADDirectoryAuditReportInfo) DirectoryAuditTargetReso
 // 	return values
 // }
 
+func (directoryObject *ADDirectoryObjectInfo) DirectoryObjectDisplayName() *string {
+	// The display name is defined on the derived types (user, group, servicePrincipal, device...) rather than on directoryObject
+	if o, ok := directoryObject.DirectoryObjectable.(interface{ GetDisplayName() *string }); ok {
+		return o.GetDisplayName()
+	}
+	return nil
+}
+
+func (directoryObject *ADDirectoryObjectInfo) DirectoryObjectRaw() interface{} {
+	content, err := serialization.SerializeToJson(directoryObject.DirectoryObjectable)
+	if err != nil {
+		return nil
+	}
+
+	var raw interface{}
+	if err := json.Unmarshal(content, &raw); err != nil {
+		return nil
+	}
+	return raw
+}
+
+func (directoryObject *ADDirectoryObjectInfo) DirectoryObjectType() string {
+	if directoryObject.GetOdataType() == nil {
+		return ""
+	}
+	return strings.TrimPrefix(*directoryObject.GetOdataType(), "#microsoft.graph.")
+}
+
+func (roleAssignment *ADDirectoryRoleAssignmentInfo) DirectoryRoleAssignmentRoleDisplayName() *string {
+	if roleAssignment.GetRoleDefinition() == nil {
+		return nil
+	}
+	return roleAssignment.GetRoleDefinition().GetDisplayName()
+}
+
+func (roleAssignment *ADDirectoryRoleAssignmentInfo) DirectoryRoleAssignmentScopeType() *string {
+	return roleScopeType(roleAssignment.GetDirectoryScopeId(), roleAssignment.GetAppScopeId())
+}
+
+func (template *ADDirectoryRoleTemplateInfo) DirectoryRoleTemplateIsActivated() bool {
+	return template.RoleId != nil
+}
+
+func (dnsRecord *ADDomainDnsRecordInfo) DomainDnsRecordText() *string {
+	// Only TXT records carry a text value
+	if txtRecord, ok := dnsRecord.DomainDnsRecordable.(models.DomainDnsTxtRecordable); ok {
+		return txtRecord.GetText()
+	}
+	return nil
+}
+
+func (policy *ADExternalIdentitiesPolicyInfo) ExternalIdentitiesPolicySelfServiceSignUpEnabled() *bool {
+	if policy.GetSelfServiceSignUp() == nil {
+		return nil
+	}
+	return policy.GetSelfServiceSignUp().GetIsEnabled()
+}
+
+func (license *ADGroupAssignedLicenseInfo) GroupAssignedLicenseDisabledPlans() []string {
+	disabledPlans := []string{}
+	for _, p := range license.GetDisabledPlans() {
+		disabledPlans = append(disabledPlans, p.String())
+	}
+	return disabledPlans
+}
+
+func (license *ADGroupAssignedLicenseInfo) GroupAssignedLicenseSkuId() string {
+	if license.GetSkuId() == nil {
+		return ""
+	}
+	return license.GetSkuId().String()
+}
+
 func (group *ADGroupInfo) GroupAssignedLabels() []map[string]*string {
 	if group.GetAssignedLabels() == nil {
 		return nil
@@ -738,6 +1483,472 @@ func (group *ADGroupInfo) GroupAssignedLabels() []map[string]*string {
 	return assignedLabels
 }
 
+func (group *ADGroupInfo) GroupHasTeam() bool {
+	return helpers.StringSliceContains(group.ResourceProvisioningOptions, "Team")
+}
+
+func (group *ADGroupInfo) GroupIsManagementRestricted() *bool {
+	// The property is missing from the SDK models, so it is read from the additional data
+	if isManagementRestricted, ok := group.GetAdditionalData()["isManagementRestricted"].(*bool); ok {
+		return isManagementRestricted
+	}
+	return nil
+}
+
+func (group *ADGroupInfo) GroupOnPremisesProvisioningErrors() []map[string]interface{} {
+	if group.GetOnPremisesProvisioningErrors() == nil {
+		return nil
+	}
+
+	provisioningErrors := []map[string]interface{}{}
+	for _, e := range group.GetOnPremisesProvisioningErrors() {
+		provisioningError := map[string]interface{}{
+			"category":             e.GetCategory(),
+			"occurredDateTime":     e.GetOccurredDateTime(),
+			"propertyCausingError": e.GetPropertyCausingError(),
+			"value":                e.GetValue(),
+		}
+		provisioningErrors = append(provisioningErrors, provisioningError)
+	}
+	return provisioningErrors
+}
+
+func (assignment *ADGroupPimAssignmentInfo) GroupPimAssignmentAccessId() string {
+	if assignment.GetAccessId() == nil {
+		return ""
+	}
+	return assignment.GetAccessId().String()
+}
+
+func (assignment *ADGroupPimAssignmentInfo) GroupPimAssignmentAssignmentType() string {
+	if assignment.GetAssignmentType() == nil {
+		return ""
+	}
+	return assignment.GetAssignmentType().String()
+}
+
+func (assignment *ADGroupPimAssignmentInfo) GroupPimAssignmentMemberType() string {
+	if assignment.GetMemberType() == nil {
+		return ""
+	}
+	return assignment.GetMemberType().String()
+}
+
+func (homeRealmDiscoveryPolicy *ADHomeRealmDiscoveryPolicyInfo) HomeRealmDiscoveryPolicyDefinition() []interface{} {
+	// Each entry of the definition is a JSON document, e.g. {"HomeRealmDiscoveryPolicy":{"AccelerateToFederatedDomain":true}}
+	definition := []interface{}{}
+	for _, d := range homeRealmDiscoveryPolicy.GetDefinition() {
+		var data interface{}
+		if err := json.Unmarshal([]byte(d), &data); err != nil {
+			definition = append(definition, d)
+			continue
+		}
+		definition = append(definition, data)
+	}
+	return definition
+}
+
+func (workflow *ADLifecycleWorkflowInfo) LifecycleWorkflowCategory() string {
+	if workflow.GetCategory() == nil {
+		return ""
+	}
+	return workflow.GetCategory().String()
+}
+
+func (workflow *ADLifecycleWorkflowInfo) LifecycleWorkflowExecutionConditions() interface{} {
+	if workflow.GetExecutionConditions() == nil {
+		return nil
+	}
+
+	// The conditions are of several types (trigger and scope based, on demand...), so they are serialized as returned by Graph
+	content, err := serialization.SerializeToJson(workflow.GetExecutionConditions())
+	if err != nil {
+		return nil
+	}
+
+	var executionConditions interface{}
+	if err := json.Unmarshal(content, &executionConditions); err != nil {
+		return nil
+	}
+	return executionConditions
+}
+
+func (branding *ADOrganizationBrandingInfo) OrganizationBrandingHasBackgroundImage() bool {
+	return branding.GetBackgroundImageRelativeUrl() != nil && *branding.GetBackgroundImageRelativeUrl() != ""
+}
+
+func (branding *ADOrganizationBrandingInfo) OrganizationBrandingHasBannerLogo() bool {
+	return branding.GetBannerLogoRelativeUrl() != nil && *branding.GetBannerLogoRelativeUrl() != ""
+}
+
+func (branding *ADOrganizationBrandingInfo) OrganizationBrandingHasSquareLogo() bool {
+	return branding.GetSquareLogoRelativeUrl() != nil && *branding.GetSquareLogoRelativeUrl() != ""
+}
+
+func (schedule *ADPimAssignmentScheduleInfo) PimAssignmentScheduleEndDateTime() *time.Time {
+	if schedule.GetScheduleInfo() == nil || schedule.GetScheduleInfo().GetExpiration() == nil {
+		return nil
+	}
+	return schedule.GetScheduleInfo().GetExpiration().GetEndDateTime()
+}
+
+func (schedule *ADPimAssignmentScheduleInfo) PimAssignmentScheduleEligibilityScheduleId() *string {
+	if schedule.GetActivatedUsing() == nil {
+		return nil
+	}
+	return schedule.GetActivatedUsing().GetId()
+}
+
+func (schedule *ADPimAssignmentScheduleInfo) PimAssignmentScheduleRoleDisplayName() *string {
+	if schedule.GetRoleDefinition() == nil {
+		return nil
+	}
+	return schedule.GetRoleDefinition().GetDisplayName()
+}
+
+func (schedule *ADPimAssignmentScheduleInfo) PimAssignmentScheduleScheduleInfo() interface{} {
+	return parsableToJson(schedule.GetScheduleInfo())
+}
+
+func (schedule *ADPimAssignmentScheduleInfo) PimAssignmentScheduleScopeType() *string {
+	return roleScopeType(schedule.GetDirectoryScopeId(), schedule.GetAppScopeId())
+}
+
+func (schedule *ADPimAssignmentScheduleInfo) PimAssignmentScheduleStartDateTime() *time.Time {
+	if schedule.GetScheduleInfo() == nil {
+		return nil
+	}
+	return schedule.GetScheduleInfo().GetStartDateTime()
+}
+
+func (instance *ADPimAssignmentScheduleInstanceInfo) PimAssignmentScheduleInstanceEligibilityScheduleInstanceId() *string {
+	if instance.GetActivatedUsing() == nil {
+		return nil
+	}
+	return instance.GetActivatedUsing().GetId()
+}
+
+func (instance *ADPimAssignmentScheduleInstanceInfo) PimAssignmentScheduleInstanceRoleDisplayName() *string {
+	if instance.GetRoleDefinition() == nil {
+		return nil
+	}
+	return instance.GetRoleDefinition().GetDisplayName()
+}
+
+func (instance *ADPimAssignmentScheduleInstanceInfo) PimAssignmentScheduleInstanceScopeType() *string {
+	return roleScopeType(instance.GetDirectoryScopeId(), instance.GetAppScopeId())
+}
+
+func (schedule *ADPimEligibilityScheduleInfo) PimEligibilityScheduleEndDateTime() *time.Time {
+	if schedule.GetScheduleInfo() == nil || schedule.GetScheduleInfo().GetExpiration() == nil {
+		return nil
+	}
+	return schedule.GetScheduleInfo().GetExpiration().GetEndDateTime()
+}
+
+func (schedule *ADPimEligibilityScheduleInfo) PimEligibilityScheduleRoleDisplayName() *string {
+	if schedule.GetRoleDefinition() == nil {
+		return nil
+	}
+	return schedule.GetRoleDefinition().GetDisplayName()
+}
+
+func (schedule *ADPimEligibilityScheduleInfo) PimEligibilityScheduleScheduleInfo() interface{} {
+	return parsableToJson(schedule.GetScheduleInfo())
+}
+
+func (schedule *ADPimEligibilityScheduleInfo) PimEligibilityScheduleScopeType() *string {
+	return roleScopeType(schedule.GetDirectoryScopeId(), schedule.GetAppScopeId())
+}
+
+func (schedule *ADPimEligibilityScheduleInfo) PimEligibilityScheduleStartDateTime() *time.Time {
+	if schedule.GetScheduleInfo() == nil {
+		return nil
+	}
+	return schedule.GetScheduleInfo().GetStartDateTime()
+}
+
+func (provisioning *ADProvisioningObjectSummaryInfo) ProvisioningObjectSummaryProvisioningAction() string {
+	if provisioning.GetProvisioningAction() == nil {
+		return ""
+	}
+	return provisioning.GetProvisioningAction().String()
+}
+
+func (provisioning *ADProvisioningObjectSummaryInfo) ProvisioningObjectSummaryServicePrincipalId() *string {
+	if provisioning.GetServicePrincipal() == nil {
+		return nil
+	}
+	return provisioning.GetServicePrincipal().GetId()
+}
+
+func (provisioning *ADProvisioningObjectSummaryInfo) ProvisioningObjectSummarySourceSystem() map[string]interface{} {
+	return provisioningSystemToMap(provisioning.GetSourceSystem())
+}
+
+func (provisioning *ADProvisioningObjectSummaryInfo) ProvisioningObjectSummaryStatus() map[string]interface{} {
+	if provisioning.GetProvisioningStatusInfo() == nil {
+		return nil
+	}
+
+	statusInfo := map[string]interface{}{}
+	if provisioning.GetProvisioningStatusInfo().GetStatus() != nil {
+		statusInfo["status"] = provisioning.GetProvisioningStatusInfo().GetStatus().String()
+	}
+	if errorInfo := provisioning.GetProvisioningStatusInfo().GetErrorInformation(); errorInfo != nil {
+		errorData := map[string]interface{}{}
+		if errorInfo.GetAdditionalDetails() != nil {
+			errorData["additionalDetails"] = *errorInfo.GetAdditionalDetails()
+		}
+		if errorInfo.GetErrorCategory() != nil {
+			errorData["errorCategory"] = errorInfo.GetErrorCategory().String()
+		}
+		if errorInfo.GetErrorCode() != nil {
+			errorData["errorCode"] = *errorInfo.GetErrorCode()
+		}
+		if errorInfo.GetReason() != nil {
+			errorData["reason"] = *errorInfo.GetReason()
+		}
+		if errorInfo.GetRecommendedAction() != nil {
+			errorData["recommendedAction"] = *errorInfo.GetRecommendedAction()
+		}
+		statusInfo["errorInformation"] = errorData
+	}
+	return statusInfo
+}
+
+func (provisioning *ADProvisioningObjectSummaryInfo) ProvisioningObjectSummaryTargetSystem() map[string]interface{} {
+	return provisioningSystemToMap(provisioning.GetTargetSystem())
+}
+
+func (resourceAction *ADRoleDefinitionResourceActionInfo) RoleDefinitionResourceActionIsPrivileged() *bool {
+	// isPrivileged is not part of the resource action model, so it is only available when returned by Graph as an additional property
+	if isPrivileged, ok := resourceAction.GetAdditionalData()["isPrivileged"].(*bool); ok {
+		return isPrivileged
+	}
+	return nil
+}
+
+func (riskDetection *ADRiskDetectionInfo) RiskDetectionActivity() string {
+	if riskDetection.GetActivity() == nil {
+		return ""
+	}
+	return riskDetection.GetActivity().String()
+}
+
+func (riskDetection *ADRiskDetectionInfo) RiskDetectionDetectionTimingType() string {
+	if riskDetection.GetDetectionTimingType() == nil {
+		return ""
+	}
+	return riskDetection.GetDetectionTimingType().String()
+}
+
+func (riskDetection *ADRiskDetectionInfo) RiskDetectionLocation() map[string]interface{} {
+	return signInLocationInfo(riskDetection.GetLocation())
+}
+
+func (riskDetection *ADRiskDetectionInfo) RiskDetectionRiskDetail() string {
+	if riskDetection.GetRiskDetail() == nil {
+		return ""
+	}
+	return riskDetection.GetRiskDetail().String()
+}
+
+func (riskDetection *ADRiskDetectionInfo) RiskDetectionRiskLevel() string {
+	if riskDetection.GetRiskLevel() == nil {
+		return ""
+	}
+	return riskDetection.GetRiskLevel().String()
+}
+
+func (riskDetection *ADRiskDetectionInfo) RiskDetectionRiskState() string {
+	if riskDetection.GetRiskState() == nil {
+		return ""
+	}
+	return riskDetection.GetRiskState().String()
+}
+
+func (roleManagementPolicy *ADRoleManagementPolicyInfo) RoleManagementPolicyDescription() *string {
+	if roleManagementPolicy.GetPolicy() == nil {
+		return nil
+	}
+	return roleManagementPolicy.GetPolicy().GetDescription()
+}
+
+func (roleManagementPolicy *ADRoleManagementPolicyInfo) RoleManagementPolicyDisplayName() *string {
+	if roleManagementPolicy.GetPolicy() == nil {
+		return nil
+	}
+	return roleManagementPolicy.GetPolicy().GetDisplayName()
+}
+
+func (roleManagementPolicy *ADRoleManagementPolicyInfo) RoleManagementPolicyLastModifiedDateTime() *time.Time {
+	if roleManagementPolicy.GetPolicy() == nil {
+		return nil
+	}
+	return roleManagementPolicy.GetPolicy().GetLastModifiedDateTime()
+}
+
+func (roleManagementPolicy *ADRoleManagementPolicyInfo) RoleManagementPolicyRules() []interface{} {
+	if roleManagementPolicy.GetPolicy() == nil || roleManagementPolicy.GetPolicy().GetRules() == nil {
+		return nil
+	}
+
+	// The rules are of several types (approval, expiration, enablement, notification...), so they are serialized as returned by Graph
+	rules := []interface{}{}
+	for _, r := range roleManagementPolicy.GetPolicy().GetRules() {
+		content, err := serialization.SerializeToJson(r)
+		if err != nil {
+			continue
+		}
+
+		var rule interface{}
+		if err := json.Unmarshal(content, &rule); err != nil {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func (request *ADRoleScheduleRequestInfo) RoleScheduleRequestCreatedBy() interface{} {
+	return parsableToJson(request.GetCreatedBy())
+}
+
+func (request *ADRoleScheduleRequestInfo) RoleScheduleRequestScheduleInfo() interface{} {
+	return parsableToJson(request.ScheduleInfo)
+}
+
+func (request *ADRoleScheduleRequestInfo) RoleScheduleRequestTicketInfo() interface{} {
+	return parsableToJson(request.TicketInfo)
+}
+
+func (secureScore *ADSecureScoreInfo) SecureScoreControlScores() []map[string]interface{} {
+	if secureScore.GetControlScores() == nil {
+		return nil
+	}
+
+	controlScores := []map[string]interface{}{}
+	for _, c := range secureScore.GetControlScores() {
+		controlScoreData := map[string]interface{}{}
+		if c.GetControlName() != nil {
+			controlScoreData["controlName"] = *c.GetControlName()
+		}
+		if c.GetControlCategory() != nil {
+			controlScoreData["controlCategory"] = *c.GetControlCategory()
+		}
+		if c.GetDescription() != nil {
+			controlScoreData["description"] = *c.GetDescription()
+		}
+		if c.GetScore() != nil {
+			controlScoreData["score"] = *c.GetScore()
+		}
+		controlScores = append(controlScores, controlScoreData)
+	}
+	return controlScores
+}
+
+func (serviceHealth *ADServiceHealthInfo) ServiceHealthStatus() string {
+	if serviceHealth.GetStatus() == nil {
+		return ""
+	}
+	return serviceHealth.GetStatus().String()
+}
+
+func (message *ADServiceMessageInfo) ServiceMessageCategory() string {
+	if message.GetCategory() == nil {
+		return ""
+	}
+	return message.GetCategory().String()
+}
+
+func (message *ADServiceMessageInfo) ServiceMessageSeverity() string {
+	if message.GetSeverity() == nil {
+		return ""
+	}
+	return message.GetSeverity().String()
+}
+
+func (credential *ADServicePrincipalCredentialInfo) ServicePrincipalCredentialCertificateIssuer() *string {
+	certificate := credential.certificate()
+	if certificate == nil {
+		return nil
+	}
+	issuer := certificate.Issuer.String()
+	return &issuer
+}
+
+func (credential *ADServicePrincipalCredentialInfo) ServicePrincipalCredentialCertificateSubject() *string {
+	certificate := credential.certificate()
+	if certificate == nil {
+		return nil
+	}
+	subject := certificate.Subject.String()
+	return &subject
+}
+
+func (credential *ADServicePrincipalCredentialInfo) ServicePrincipalCredentialCustomKeyIdentifier() *string {
+	if len(credential.CustomKeyIdentifier) == 0 {
+		return nil
+	}
+
+	// The custom key identifier of a certificate is usually its thumbprint, which is displayed as an uppercase hex string
+	customKeyIdentifier := strings.ToUpper(hex.EncodeToString(credential.CustomKeyIdentifier))
+	return &customKeyIdentifier
+}
+
+// ServicePrincipalCredentialDaysUntilExpiry returns the number of whole days left before the credential expires, negative once it has expired
+func (credential *ADServicePrincipalCredentialInfo) ServicePrincipalCredentialDaysUntilExpiry() *int64 {
+	if credential.EndDateTime == nil {
+		return nil
+	}
+
+	days := int64(math.Floor(time.Until(*credential.EndDateTime).Hours() / 24))
+	return &days
+}
+
+func (credential *ADServicePrincipalCredentialInfo) ServicePrincipalCredentialExpiryStatus() string {
+	if credential.EndDateTime == nil {
+		return "valid"
+	}
+
+	now := time.Now()
+	if credential.EndDateTime.Before(now) {
+		return "expired"
+	}
+	if credential.EndDateTime.Before(now.Add(credential.ExpiryWarningPeriod)) {
+		return "expiring"
+	}
+	return "valid"
+}
+
+func (credential *ADServicePrincipalCredentialInfo) ServicePrincipalCredentialIsSniEnabled() *bool {
+	// Subject name and issuer (SNI) authentication relies on a certificate issued by a trusted CA, which is verified when the app authenticates.
+	// A self-signed certificate can't be used, and without the certificate itself, the issuer is unknown.
+	if credential.Type == nil || *credential.Type != "AsymmetricX509Cert" || credential.Usage == nil || *credential.Usage != "Verify" {
+		return Bool(false)
+	}
+
+	certificate := credential.certificate()
+	if certificate == nil {
+		return nil
+	}
+	return Bool(certificate.Issuer.String() != certificate.Subject.String())
+}
+
+// certificate parses the X.509 certificate of a key credential, which Graph only returns when a single object is read with the keyCredentials selected
+func (credential *ADServicePrincipalCredentialInfo) certificate() *x509.Certificate {
+	if credential.Type == nil || *credential.Type != "AsymmetricX509Cert" || len(credential.Key) == 0 {
+		return nil
+	}
+
+	certificate, err := x509.ParseCertificate(credential.Key)
+	if err != nil {
+		return nil
+	}
+	return certificate
+}
+
 func (servicePrincipal *ADServicePrincipalInfo) ServicePrincipalAddIns() []map[string]interface{} {
 	if servicePrincipal.GetAddIns() == nil {
 		return nil
@@ -804,6 +2015,37 @@ func (servicePrincipal *ADServicePrincipalInfo) ServicePrincipalAppRoles() []map
 	return appRoles
 }
 
+func (servicePrincipal *ADServicePrincipalInfo) ServicePrincipalEarliestCredentialExpiry() *time.Time {
+	var earliest *time.Time
+	for _, endDateTime := range servicePrincipal.credentialEndDateTimes() {
+		if earliest == nil || endDateTime.Before(*earliest) {
+			t := endDateTime
+			earliest = &t
+		}
+	}
+	return earliest
+}
+
+func (servicePrincipal *ADServicePrincipalInfo) ServicePrincipalHasExpiredCredentials() bool {
+	now := time.Now()
+	for _, endDateTime := range servicePrincipal.credentialEndDateTimes() {
+		if endDateTime.Before(now) {
+			return true
+		}
+	}
+	return false
+}
+
+func (servicePrincipal *ADServicePrincipalInfo) ServicePrincipalHasExpiringCredentials() bool {
+	now := time.Now()
+	for _, endDateTime := range servicePrincipal.credentialEndDateTimes() {
+		if !endDateTime.Before(now) && endDateTime.Before(now.Add(servicePrincipal.ExpiryWarningPeriod)) {
+			return true
+		}
+	}
+	return false
+}
+
 func (servicePrincipal *ADServicePrincipalInfo) ServicePrincipalInfo() map[string]interface{} {
 	if servicePrincipal.GetInfo() == nil {
 		return nil
@@ -818,6 +2060,16 @@ func (servicePrincipal *ADServicePrincipalInfo) ServicePrincipalInfo() map[strin
 	}
 }
 
+func (servicePrincipal *ADServicePrincipalInfo) ServicePrincipalIsGalleryApp() bool {
+	// The service principals added from the app gallery are tagged WindowsAzureActiveDirectoryGalleryApplicationPrimaryV1 or WindowsAzureActiveDirectoryGalleryApplicationNonPrimaryV1
+	for _, tag := range servicePrincipal.GetTags() {
+		if strings.HasPrefix(tag, "WindowsAzureActiveDirectoryGalleryApplication") {
+			return true
+		}
+	}
+	return false
+}
+
 func (servicePrincipal *ADServicePrincipalInfo) ServicePrincipalKeyCredentials() []map[string]interface{} {
 	if servicePrincipal.GetKeyCredentials() == nil {
 		return nil
@@ -929,6 +2181,59 @@ func (servicePrincipal *ADServicePrincipalInfo) ServicePrincipalPasswordCredenti
 	return passwordCredentials
 }
 
+// requiresGrantControl reports whether one of the given built-in grant controls must be satisfied for the policy to grant access, where
+// authenticationStrength stands for the authentication strength of the policy. With the OR operator, any single control satisfies
+// the policy, so the given controls are only required when the policy has no other control.
+func (conditionalAccessPolicy *ADConditionalAccessPolicyInfo) requiresGrantControl(controls ...string) bool {
+	grantControls := conditionalAccessPolicy.GetGrantControls()
+	if grantControls == nil {
+		return false
+	}
+
+	policyControls := []string{}
+	for _, c := range grantControls.GetBuiltInControls() {
+		policyControls = append(policyControls, c.String())
+	}
+	if grantControls.GetAuthenticationStrength() != nil {
+		policyControls = append(policyControls, "authenticationStrength")
+	}
+
+	found := false
+	otherCount := len(grantControls.GetCustomAuthenticationFactors()) + len(grantControls.GetTermsOfUse())
+	for _, c := range policyControls {
+		if helpers.StringSliceContains(controls, c) {
+			found = true
+		} else {
+			otherCount++
+		}
+	}
+	if !found {
+		return false
+	}
+
+	if grantControls.GetOperator() == nil || strings.ToUpper(*grantControls.GetOperator()) != "OR" {
+		return true
+	}
+
+	return otherCount == 0
+}
+
+// credentialEndDateTimes returns the expiry dates of both the key and the password credentials of the service principal
+func (servicePrincipal *ADServicePrincipalInfo) credentialEndDateTimes() []time.Time {
+	endDateTimes := []time.Time{}
+	for _, p := range servicePrincipal.GetKeyCredentials() {
+		if p.GetEndDateTime() != nil {
+			endDateTimes = append(endDateTimes, *p.GetEndDateTime())
+		}
+	}
+	for _, p := range servicePrincipal.GetPasswordCredentials() {
+		if p.GetEndDateTime() != nil {
+			endDateTimes = append(endDateTimes, *p.GetEndDateTime())
+		}
+	}
+	return endDateTimes
+}
+
 func (signIn *ADSignInReportInfo) SignInAppliedConditionalAccessPolicies() []map[string]interface{} {
 	if signIn.GetAppliedConditionalAccessPolicies() == nil {
 		return nil
@@ -1004,34 +2309,92 @@ func (signIn *ADSignInReportInfo) SignInStatus() map[string]interface{} {
 }
 
 func (signIn *ADSignInReportInfo) SignInLocation() map[string]interface{} {
-	if signIn.GetLocation() == nil {
+	return signInLocationInfo(signIn.GetLocation())
+}
+
+func (tenant *ADTenantInfo) TenantDefaultDomain() *string {
+	for _, domain := range tenant.GetVerifiedDomains() {
+		if domain.GetIsDefault() != nil && *domain.GetIsDefault() {
+			return domain.GetName()
+		}
+	}
+	return nil
+}
+
+func (tenantRelationship *ADTenantRelationshipInfo) TenantRelationshipAccessDetails() map[string]interface{} {
+	if tenantRelationship.GetAccessDetails() == nil {
 		return nil
 	}
 
-	locationInfo := map[string]interface{}{}
-	if signIn.GetLocation().GetCity() != nil {
-		locationInfo["city"] = *signIn.GetLocation().GetCity()
+	unifiedRoles := []map[string]interface{}{}
+	for _, r := range tenantRelationship.GetAccessDetails().GetUnifiedRoles() {
+		data := map[string]interface{}{}
+		if r.GetRoleDefinitionId() != nil {
+			data["roleDefinitionId"] = *r.GetRoleDefinitionId()
+		}
+		unifiedRoles = append(unifiedRoles, data)
 	}
-	if signIn.GetLocation().GetCountryOrRegion() != nil {
-		locationInfo["countryOrRegion"] = *signIn.GetLocation().GetCountryOrRegion()
+
+	return map[string]interface{}{
+		"unifiedRoles": unifiedRoles,
 	}
-	if signIn.GetLocation().GetState() != nil {
-		locationInfo["state"] = *signIn.GetLocation().GetState()
+}
+
+func (tenantRelationship *ADTenantRelationshipInfo) TenantRelationshipAutoExtendDuration() string {
+	if tenantRelationship.GetAutoExtendDuration() == nil {
+		return ""
 	}
-	if signIn.GetLocation().GetGeoCoordinates() != nil {
-		coordinateInfo := map[string]interface{}{}
-		if signIn.GetLocation().GetGeoCoordinates().GetAltitude() != nil {
-			coordinateInfo["altitude"] = *signIn.GetLocation().GetGeoCoordinates().GetAltitude()
-		}
-		if signIn.GetLocation().GetGeoCoordinates().GetLatitude() != nil {
-			coordinateInfo["latitude"] = *signIn.GetLocation().GetGeoCoordinates().GetLatitude()
-		}
-		if signIn.GetLocation().GetGeoCoordinates().GetLongitude() != nil {
-			coordinateInfo["longitude"] = *signIn.GetLocation().GetGeoCoordinates().GetLongitude()
-		}
-		locationInfo["geoCoordinates"] = coordinateInfo
+	return tenantRelationship.GetAutoExtendDuration().String()
+}
+
+func (tenantRelationship *ADTenantRelationshipInfo) TenantRelationshipCustomerDisplayName() *string {
+	if tenantRelationship.GetCustomer() == nil {
+		return nil
 	}
-	return locationInfo
+	return tenantRelationship.GetCustomer().GetDisplayName()
+}
+
+func (tenantRelationship *ADTenantRelationshipInfo) TenantRelationshipCustomerTenantId() *string {
+	if tenantRelationship.GetCustomer() == nil {
+		return nil
+	}
+	return tenantRelationship.GetCustomer().GetTenantId()
+}
+
+func (tenantRelationship *ADTenantRelationshipInfo) TenantRelationshipDuration() string {
+	if tenantRelationship.GetDuration() == nil {
+		return ""
+	}
+	return tenantRelationship.GetDuration().String()
+}
+
+func (tenantRelationship *ADTenantRelationshipInfo) TenantRelationshipStatus() string {
+	if tenantRelationship.GetStatus() == nil {
+		return ""
+	}
+	return tenantRelationship.GetStatus().String()
+}
+
+func (agreement *ADTermsOfUseAgreementInfo) TermsOfUseAgreementTermsExpiration() map[string]interface{} {
+	if agreement.GetTermsExpiration() == nil {
+		return nil
+	}
+
+	termsExpiration := map[string]interface{}{}
+	if agreement.GetTermsExpiration().GetFrequency() != nil {
+		termsExpiration["frequency"] = agreement.GetTermsExpiration().GetFrequency().String()
+	}
+	if agreement.GetTermsExpiration().GetStartDateTime() != nil {
+		termsExpiration["startDateTime"] = *agreement.GetTermsExpiration().GetStartDateTime()
+	}
+	return termsExpiration
+}
+
+func (agreement *ADTermsOfUseAgreementInfo) TermsOfUseAgreementUserReacceptRequiredFrequency() string {
+	if agreement.GetUserReacceptRequiredFrequency() == nil {
+		return ""
+	}
+	return agreement.GetUserReacceptRequiredFrequency().String()
 }
 
 func (user *ADUserInfo) UserMemberOf() []map[string]interface{} {
@@ -1050,6 +2413,61 @@ func (user *ADUserInfo) UserMemberOf() []map[string]interface{} {
 	return members
 }
 
+func (user *ADUserInfo) UserOnPremisesExtensionAttributes() map[string]interface{} {
+	if user.GetOnPremisesExtensionAttributes() == nil {
+		return nil
+	}
+	attributes := user.GetOnPremisesExtensionAttributes()
+
+	data := map[string]interface{}{}
+	if attributes.GetExtensionAttribute1() != nil {
+		data["extensionAttribute1"] = *attributes.GetExtensionAttribute1()
+	}
+	if attributes.GetExtensionAttribute2() != nil {
+		data["extensionAttribute2"] = *attributes.GetExtensionAttribute2()
+	}
+	if attributes.GetExtensionAttribute3() != nil {
+		data["extensionAttribute3"] = *attributes.GetExtensionAttribute3()
+	}
+	if attributes.GetExtensionAttribute4() != nil {
+		data["extensionAttribute4"] = *attributes.GetExtensionAttribute4()
+	}
+	if attributes.GetExtensionAttribute5() != nil {
+		data["extensionAttribute5"] = *attributes.GetExtensionAttribute5()
+	}
+	if attributes.GetExtensionAttribute6() != nil {
+		data["extensionAttribute6"] = *attributes.GetExtensionAttribute6()
+	}
+	if attributes.GetExtensionAttribute7() != nil {
+		data["extensionAttribute7"] = *attributes.GetExtensionAttribute7()
+	}
+	if attributes.GetExtensionAttribute8() != nil {
+		data["extensionAttribute8"] = *attributes.GetExtensionAttribute8()
+	}
+	if attributes.GetExtensionAttribute9() != nil {
+		data["extensionAttribute9"] = *attributes.GetExtensionAttribute9()
+	}
+	if attributes.GetExtensionAttribute10() != nil {
+		data["extensionAttribute10"] = *attributes.GetExtensionAttribute10()
+	}
+	if attributes.GetExtensionAttribute11() != nil {
+		data["extensionAttribute11"] = *attributes.GetExtensionAttribute11()
+	}
+	if attributes.GetExtensionAttribute12() != nil {
+		data["extensionAttribute12"] = *attributes.GetExtensionAttribute12()
+	}
+	if attributes.GetExtensionAttribute13() != nil {
+		data["extensionAttribute13"] = *attributes.GetExtensionAttribute13()
+	}
+	if attributes.GetExtensionAttribute14() != nil {
+		data["extensionAttribute14"] = *attributes.GetExtensionAttribute14()
+	}
+	if attributes.GetExtensionAttribute15() != nil {
+		data["extensionAttribute15"] = *attributes.GetExtensionAttribute15()
+	}
+	return data
+}
+
 func (user *ADUserInfo) UserPasswordProfile() map[string]interface{} {
 	if user.GetPasswordProfile() == nil {
 		return nil
@@ -1068,3 +2486,116 @@ func (user *ADUserInfo) UserPasswordProfile() map[string]interface{} {
 
 	return passwordProfileData
 }
+
+func (eligibility *ADUserRoleEligibilityInfo) UserRoleEligibilityRoleDisplayName() *string {
+	if eligibility.GetRoleDefinition() == nil {
+		return nil
+	}
+	return eligibility.GetRoleDefinition().GetDisplayName()
+}
+
+func provisioningSystemToMap(system models.ProvisioningSystemable) map[string]interface{} {
+	if system == nil {
+		return nil
+	}
+
+	systemData := map[string]interface{}{}
+	if system.GetId() != nil {
+		systemData["id"] = *system.GetId()
+	}
+	if system.GetDisplayName() != nil {
+		systemData["displayName"] = *system.GetDisplayName()
+	}
+	if system.GetDetails() != nil {
+		systemData["details"] = system.GetDetails().GetAdditionalData()
+	}
+	return systemData
+}
+
+func optionalClaimsToMap(claims []models.OptionalClaimable) []map[string]interface{} {
+	data := []map[string]interface{}{}
+	for _, claim := range claims {
+		claimData := map[string]interface{}{
+			"additionalProperties": claim.GetAdditionalProperties(),
+		}
+		if claim.GetName() != nil {
+			claimData["name"] = *claim.GetName()
+		}
+		if claim.GetSource() != nil {
+			claimData["source"] = *claim.GetSource()
+		}
+		if claim.GetEssential() != nil {
+			claimData["essential"] = *claim.GetEssential()
+		}
+		data = append(data, claimData)
+	}
+	return data
+}
+
+// signInLocationInfo returns the city, state, country and coordinates of the location of a sign-in
+func signInLocationInfo(location models.SignInLocationable) map[string]interface{} {
+	if location == nil {
+		return nil
+	}
+
+	locationInfo := map[string]interface{}{}
+	if location.GetCity() != nil {
+		locationInfo["city"] = *location.GetCity()
+	}
+	if location.GetCountryOrRegion() != nil {
+		locationInfo["countryOrRegion"] = *location.GetCountryOrRegion()
+	}
+	if location.GetState() != nil {
+		locationInfo["state"] = *location.GetState()
+	}
+	if location.GetGeoCoordinates() != nil {
+		coordinateInfo := map[string]interface{}{}
+		if location.GetGeoCoordinates().GetAltitude() != nil {
+			coordinateInfo["altitude"] = *location.GetGeoCoordinates().GetAltitude()
+		}
+		if location.GetGeoCoordinates().GetLatitude() != nil {
+			coordinateInfo["latitude"] = *location.GetGeoCoordinates().GetLatitude()
+		}
+		if location.GetGeoCoordinates().GetLongitude() != nil {
+			coordinateInfo["longitude"] = *location.GetGeoCoordinates().GetLongitude()
+		}
+		locationInfo["geoCoordinates"] = coordinateInfo
+	}
+	return locationInfo
+}
+
+// parsableToJson converts a Graph model to its JSON representation, as returned by Graph
+func parsableToJson(value serialization.Parsable) interface{} {
+	if value == nil {
+		return nil
+	}
+
+	content, err := serialization.SerializeToJson(value)
+	if err != nil {
+		return nil
+	}
+
+	var data interface{}
+	if err := json.Unmarshal(content, &data); err != nil {
+		return nil
+	}
+	return data
+}
+
+// roleScopeType describes where a directory role applies, from the directory and the app scopes of its assignment or eligibility
+func roleScopeType(directoryScopeId *string, appScopeId *string) *string {
+	var scopeType string
+	switch {
+	case appScopeId != nil && *appScopeId != "" && *appScopeId != "/":
+		scopeType = "application"
+	case directoryScopeId == nil:
+		return nil
+	case *directoryScopeId == "/":
+		scopeType = "tenant"
+	case strings.HasPrefix(*directoryScopeId, "/administrativeUnits/"):
+		scopeType = "administrativeUnit"
+	default:
+		scopeType = "directoryObject"
+	}
+	return &scopeType
+}
@@ -2,8 +2,19 @@ package azuread
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"os"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/microsoft/kiota-abstractions-go/serialization"
+	"github.com/microsoftgraph/msgraph-sdk-go/directoryobjects"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/serviceprincipals"
+
+	"github.com/turbot/go-kit/helpers"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/memoize"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
@@ -17,6 +28,99 @@ const (
 	ColumnDescriptionTitle  = "Title of the resource."
 )
 
+// defaultExpiryWarningDays is how many days ahead a credential is reported as expiring when credential_expiry_warning_days is not set
+const defaultExpiryWarningDays = 30
+
+// getCredentialExpiryWarningPeriod returns how far ahead a credential is reported as expiring, from the credential_expiry_warning_days of the connection
+func getCredentialExpiryWarningPeriod(d *plugin.QueryData) time.Duration {
+	days := defaultExpiryWarningDays
+
+	azureADConfig := GetConfig(d.Connection)
+	if azureADConfig.ExpiryWarningDays != nil && *azureADConfig.ExpiryWarningDays >= 0 {
+		days = *azureADConfig.ExpiryWarningDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// buildDisplayNameSearch translates a like or ilike qual on display_name into a $search expression, e.g. 'finance%' into "displayName:finance".
+// $search matches the words of the display name that start with the term, ignoring case, so it returns at least all the names starting with it
+// and the quals are still applied by Steampipe on the returned rows. Other patterns, e.g. '%finance%', could miss names containing the term
+// inside a word and return an empty string, those are filtered client side only.
+func buildDisplayNameSearch(quals plugin.KeyColumnQualMap) string {
+	if quals["display_name"] == nil {
+		return ""
+	}
+
+	for _, q := range quals["display_name"].Quals {
+		if q.Operator != "~~" && q.Operator != "~~*" {
+			continue
+		}
+
+		pattern := q.Value.GetStringValue()
+		if !strings.HasSuffix(pattern, "%") {
+			continue
+		}
+
+		term := strings.TrimRight(pattern, "%")
+		if term == "" || strings.ContainsAny(term, "%_") {
+			continue
+		}
+
+		// The search clause is enclosed in double quotes, so backslashes and double quotes in the term must be escaped
+		term = strings.ReplaceAll(term, `\`, `\\`)
+		term = strings.ReplaceAll(term, `"`, `\"`)
+
+		return fmt.Sprintf("\"displayName:%s\"", term)
+	}
+
+	return ""
+}
+
+// escapeODataString escapes a value enclosed in single quotes in a $filter expression, where a single quote is written as two single quotes
+func escapeODataString(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
+
+// matchesTimestampQuals reports whether a time satisfies all the quals on a timestamp column, for the APIs which can't filter on it.
+// A nil time never satisfies a qual.
+func matchesTimestampQuals(value *time.Time, quals *plugin.KeyColumnQuals) bool {
+	if quals == nil {
+		return true
+	}
+	if value == nil {
+		return false
+	}
+
+	for _, q := range quals.Quals {
+		givenTime := q.Value.GetTimestampValue().AsTime()
+
+		switch q.Operator {
+		case ">":
+			if !value.After(givenTime) {
+				return false
+			}
+		case ">=":
+			if value.Before(givenTime) {
+				return false
+			}
+		case "=":
+			if !value.Equal(givenTime) {
+				return false
+			}
+		case "<=":
+			if value.After(givenTime) {
+				return false
+			}
+		case "<":
+			if !value.Before(givenTime) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 func TagsToMap(tags []string) (*map[string]bool, error) {
 	var turbotTagsMap map[string]bool
 	if tags == nil {
@@ -31,6 +135,24 @@ func TagsToMap(tags []string) (*map[string]bool, error) {
 	return &turbotTagsMap, nil
 }
 
+// privilegedRoleTemplateIds maps the template IDs of the highly privileged built-in directory roles to their display names
+var privilegedRoleTemplateIds = map[string]string{
+	"62e90394-69f5-4237-9190-012177145e10": "Global Administrator",
+	"e8611ab8-c189-46e8-94e1-60213ab1f814": "Privileged Role Administrator",
+	"7be44c8a-adaf-4e2a-84d6-ab2649e08a13": "Privileged Authentication Administrator",
+	"194ae4cb-b126-40b2-bd5b-6091b380977d": "Security Administrator",
+	"b1be1c3e-b65d-4f19-8427-f6fa0d97feb9": "Conditional Access Administrator",
+	"fe930be7-5e62-47db-91af-98c3a49a38b1": "User Administrator",
+	"c4e39bd9-1100-46d3-8c65-fb160da0071f": "Authentication Administrator",
+	"729827e3-9c14-49f7-bb1b-9608f156bbb8": "Helpdesk Administrator",
+	"9b895d92-2cd3-44c7-9d02-a6ac2d5ea5c3": "Application Administrator",
+	"158c047a-c907-4556-b7ef-446551a6b5f7": "Cloud Application Administrator",
+	"29232cdf-9323-42fd-ade2-1d097af3e4de": "Exchange Administrator",
+	"f28a1f50-f6e7-4571-818b-6a12f2af6b6c": "SharePoint Administrator",
+	"3a2c62db-5318-420d-8d74-23affee5d9d5": "Intune Administrator",
+	"b0f54661-2d74-4c50-afa3-1ec803f12efe": "Billing Administrator",
+}
+
 type QualsColumn struct {
 	ColumnName string
 	ColumnType string
@@ -49,6 +171,70 @@ func commonColumns(c []*plugin.Column) []*plugin.Column {
 	}, c...)
 }
 
+// rawColumn returns the raw column of the main tables, which holds the full Graph object of the row when include_raw is set in the connection config
+func rawColumn() *plugin.Column {
+	return &plugin.Column{
+		Name:        "raw",
+		Type:        proto.ColumnType_JSON,
+		Description: "The full Microsoft Graph object of the row. Only set when include_raw is true in the connection config.",
+		Hydrate:     getRawItem,
+		Transform:   transform.FromValue(),
+	}
+}
+
+// isRawRequested reports whether the raw column is set for the query, i.e. queried with include_raw enabled in the connection config
+func isRawRequested(d *plugin.QueryData) bool {
+	config := GetConfig(d.Connection)
+	return config.IncludeRaw != nil && *config.IncludeRaw && helpers.StringSliceContains(d.QueryContext.Columns, "raw")
+}
+
+func getRawItem(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	config := GetConfig(d.Connection)
+	if config.IncludeRaw == nil || !*config.IncludeRaw {
+		return nil, nil
+	}
+
+	// The row types embed the Graph model, so they are serialized the same way as the model
+	item, ok := h.Item.(serialization.Parsable)
+	if !ok {
+		return nil, nil
+	}
+
+	content, err := serialization.SerializeToJson(item)
+	if err != nil {
+		plugin.Logger(ctx).Error("getRawItem", "serialization_error", err)
+		return nil, err
+	}
+
+	var raw interface{}
+	if err := json.Unmarshal(content, &raw); err != nil {
+		plugin.Logger(ctx).Error("getRawItem", "unmarshal_error", err)
+		return nil, err
+	}
+	return raw, nil
+}
+
+// customSecurityAttributeValues converts the custom security attributes of a user or a service principal to a map of the attribute sets
+// to their attribute values. The attributes are returned by Graph as open types, so they are serialized as returned by Graph.
+func customSecurityAttributeValues(ctx context.Context, attributes models.CustomSecurityAttributeValueable) interface{} {
+	if attributes == nil {
+		return nil
+	}
+
+	content, err := serialization.SerializeToJson(attributes)
+	if err != nil {
+		plugin.Logger(ctx).Error("customSecurityAttributeValues", "serialization_error", err)
+		return nil
+	}
+
+	var values map[string]interface{}
+	if err := json.Unmarshal(content, &values); err != nil {
+		plugin.Logger(ctx).Error("customSecurityAttributeValues", "unmarshal_error", err)
+		return nil
+	}
+	return values
+}
+
 // if the caching is required other than per connection, build a cache key for the call and use it in Memoize
 // since getTenant is a call, caching should be per connection
 var getTenantMemoized = plugin.HydrateFunc(getTenantUncached).Memoize(memoize.WithCacheKeyFunction(getTenantCacheKey))
@@ -87,7 +273,7 @@ func getTenantUncached(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 
 		// If not set in config, get tenant ID from CLI
 		if tenantID == "" {
-			tenantID, err = getTenantFromCLI()
+			tenantID, err = getTenantFromCLI(ctx)
 			if err != nil {
 				return nil, err
 			}
@@ -99,6 +285,61 @@ func getTenantUncached(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 	return tenantID, nil
 }
 
+// servicePrincipalByAppIdLocks holds a mutex per application ID, so concurrent hydrates resolving the same application make a single call
+// while the other applications are resolved in parallel
+var servicePrincipalByAppIdLocks sync.Map
+
+// servicePrincipalByAppIdResult is the cached result of a lookup, with a nil service principal when the application has none in the tenant
+type servicePrincipalByAppIdResult struct {
+	servicePrincipal models.ServicePrincipalable
+}
+
+// getServicePrincipalByAppId returns the service principal of an application, including its published app roles and OAuth2 permission scopes.
+// The result is cached per connection, including when the application has no service principal, so resolving the same resource application
+// across many rows costs a single call.
+func getServicePrincipalByAppId(ctx context.Context, d *plugin.QueryData, appId string) (models.ServicePrincipalable, error) {
+	cacheKey := "getServicePrincipalByAppId-" + appId
+
+	lock, _ := servicePrincipalByAppIdLocks.LoadOrStore(appId, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*servicePrincipalByAppIdResult).servicePrincipal, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getServicePrincipalByAppId", "connection_error", err)
+		return nil, err
+	}
+
+	filter := fmt.Sprintf("appId eq '%s'", escapeODataString(appId))
+	options := &serviceprincipals.ServicePrincipalsRequestBuilderGetRequestConfiguration{
+		QueryParameters: &serviceprincipals.ServicePrincipalsRequestBuilderGetQueryParameters{
+			Filter: &filter,
+			Select: []string{"id", "appId", "appOwnerOrganizationId", "displayName", "appRoles", "oauth2PermissionScopes"},
+		},
+	}
+
+	result, err := client.ServicePrincipals().Get(ctx, options)
+	if err != nil {
+		errObj := getErrorObject(err)
+		plugin.Logger(ctx).Error("getServicePrincipalByAppId", "list_service_principal_error", errObj)
+		return nil, errObj
+	}
+
+	// The application may not have a service principal in this tenant
+	var servicePrincipal models.ServicePrincipalable
+	if len(result.GetValue()) > 0 {
+		servicePrincipal = result.GetValue()[0]
+	}
+	d.ConnectionManager.Cache.Set(cacheKey, &servicePrincipalByAppIdResult{servicePrincipal})
+
+	return servicePrincipal, nil
+}
+
 // Int32 returns a pointer to the int32 value passed in.
 func Int32(v int32) *int32 {
 	return &v
@@ -108,3 +349,117 @@ func Int32(v int32) *int32 {
 func Bool(v bool) *bool {
 	return &v
 }
+
+// isoDurationDays returns the number of whole days of an ISO 8601 duration, e.g. 90 for P90D, counting a year as 365 days
+func isoDurationDays(duration *serialization.ISODuration) *int64 {
+	if duration == nil {
+		return nil
+	}
+
+	days := int64(duration.GetYears())*365 + int64(duration.GetWeeks())*7 + int64(duration.GetDays()) + int64(duration.GetHours())/24
+	return &days
+}
+
+// directoryObjectNotFoundErrors are the errors returned for a deleted object, which is cached as missing
+var directoryObjectNotFoundErrors = []string{"Request_ResourceNotFound", "Invalid object identifier"}
+
+// resolveDirectoryObjectName returns the display name and the type of a directory object of any type by its ID.
+// The result is kept in the display name cache of the connection, see getDisplayNameCache, including the deleted objects,
+// for which the not found error is returned again without a call.
+func resolveDirectoryObjectName(ctx context.Context, d *plugin.QueryData, objectId string) (*directoryObjectName, error) {
+	cache := getDisplayNameCache(d)
+
+	if name, ok := cache.Get(objectId); ok {
+		if name == nil {
+			return nil, &RequestError{Code: "Request_ResourceNotFound", Message: fmt.Sprintf("Resource '%s' does not exist or one of its queried reference-property objects are not present.", objectId)}
+		}
+		return name, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("resolveDirectoryObjectName", "connection_error", err)
+		return nil, err
+	}
+
+	result, err := client.DirectoryObjects().ByDirectoryObjectId(objectId).Get(ctx, nil)
+	if err != nil {
+		errObj := getErrorObject(err)
+		if isIgnorableErrorPredicate(directoryObjectNotFoundErrors)(ctx, d, nil, errObj) {
+			cache.Add(objectId, nil)
+		}
+		plugin.Logger(ctx).Error("resolveDirectoryObjectName", "get_directory_object_error", errObj)
+		return nil, errObj
+	}
+
+	name := newDirectoryObjectName(&ADDirectoryObjectInfo{result})
+	cache.Add(objectId, name)
+
+	return name, nil
+}
+
+// resolveDirectoryObjectNames returns the display names and the types of directory objects of any type, keyed by object ID.
+// The objects missing from the display name cache are resolved in batches with the getByIds action, and the deleted objects are left out.
+// The deleted objects are cached as missing, so they are not requested again by the next rows.
+func resolveDirectoryObjectNames(ctx context.Context, d *plugin.QueryData, objectIds []string) (map[string]*directoryObjectName, error) {
+	cache := getDisplayNameCache(d)
+
+	names := map[string]*directoryObjectName{}
+	uncachedIds := []string{}
+	for _, objectId := range objectIds {
+		if name, ok := cache.Get(objectId); ok {
+			if name != nil {
+				names[objectId] = name
+			}
+		} else {
+			uncachedIds = append(uncachedIds, objectId)
+		}
+	}
+
+	if len(uncachedIds) == 0 {
+		return names, nil
+	}
+
+	// Create client
+	client, _, err := GetGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("resolveDirectoryObjectNames", "connection_error", err)
+		return nil, err
+	}
+
+	for start := 0; start < len(uncachedIds); start += directoryObjectsByIdsBatchSize {
+		end := start + directoryObjectsByIdsBatchSize
+		if end > len(uncachedIds) {
+			end = len(uncachedIds)
+		}
+
+		body := directoryobjects.NewGetByIdsPostRequestBody()
+		body.SetIds(uncachedIds[start:end])
+
+		result, err := client.DirectoryObjects().GetByIds().PostAsGetByIdsPostResponse(ctx, body, nil)
+		if err != nil {
+			errObj := getErrorObject(err)
+			plugin.Logger(ctx).Error("resolveDirectoryObjectNames", "get_directory_objects_by_ids_error", errObj)
+			return nil, errObj
+		}
+
+		for _, directoryObject := range result.GetValue() {
+			if directoryObject.GetId() == nil {
+				continue
+			}
+			name := newDirectoryObjectName(&ADDirectoryObjectInfo{directoryObject})
+			cache.Add(*directoryObject.GetId(), name)
+			names[*directoryObject.GetId()] = name
+		}
+
+		// The objects not returned have been deleted
+		for _, objectId := range uncachedIds[start:end] {
+			if _, ok := names[objectId]; !ok {
+				cache.Add(objectId, nil)
+			}
+		}
+	}
+
+	return names, nil
+}